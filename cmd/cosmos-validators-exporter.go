@@ -1,10 +1,13 @@
 package main
 
 import (
+	"fmt"
 	"main/pkg"
 	configPkg "main/pkg/config"
 	"main/pkg/logger"
 	"os"
+	"os/signal"
+	"syscall"
 
 	"github.com/spf13/cobra"
 )
@@ -20,10 +23,35 @@ func (fs *OsFS) ReadFile(name string) ([]byte, error) {
 	return os.ReadFile(name)
 }
 
+// WriteFile writes to a temporary file first and then renames it, so the file
+// is never left partially written if the app is killed in the middle of writing.
+func (fs *OsFS) WriteFile(name string, data []byte) error {
+	tmpName := name + ".tmp"
+
+	if err := os.WriteFile(tmpName, data, 0o600); err != nil {
+		return err
+	}
+
+	return os.Rename(tmpName, name)
+}
+
 func ExecuteMain(configPath string) {
 	filesystem := &OsFS{}
 	app := pkg.NewApp(configPath, filesystem, version)
+
+	stopped := make(chan struct{})
+
+	go func() {
+		signals := make(chan os.Signal, 1)
+		signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+		<-signals
+
+		app.Stop()
+		close(stopped)
+	}()
+
 	app.Start()
+	<-stopped
 }
 
 func ExecuteValidateConfig(configPath string) {
@@ -52,8 +80,27 @@ func ExecuteValidateConfig(configPath string) {
 	logger.GetDefaultLogger().Info().Msg("Provided config is valid.")
 }
 
+func ExecuteGraph(configPath string, format string) {
+	filesystem := &OsFS{}
+	app := pkg.NewApp(configPath, filesystem, version)
+
+	switch format {
+	case "text":
+		fmt.Print(app.Controller.ExecutionPlan.String())
+	case "dot":
+		fmt.Print(app.Controller.ExecutionPlan.DOT())
+	default:
+		logger.GetDefaultLogger().Panic().
+			Str("format", format).
+			Msg("Unsupported graph format, expected text or dot")
+	}
+}
+
 func main() {
-	var ConfigPath string
+	var (
+		ConfigPath  string
+		GraphFormat string
+	)
 
 	rootCmd := &cobra.Command{
 		Use:     "cosmos-validators-exporter --config [config path]",
@@ -73,13 +120,27 @@ func main() {
 		},
 	}
 
+	graphCmd := &cobra.Command{
+		Use:     "graph --config [config path] --format [text|dot]",
+		Long:    "Print the fetchers execution plan.",
+		Version: version,
+		Run: func(cmd *cobra.Command, args []string) {
+			ExecuteGraph(ConfigPath, GraphFormat)
+		},
+	}
+
 	rootCmd.PersistentFlags().StringVar(&ConfigPath, "config", "", "Config file path")
 	_ = rootCmd.MarkPersistentFlagRequired("config")
 
 	validateConfigCmd.PersistentFlags().StringVar(&ConfigPath, "config", "", "Config file path")
 	_ = validateConfigCmd.MarkPersistentFlagRequired("config")
 
+	graphCmd.PersistentFlags().StringVar(&ConfigPath, "config", "", "Config file path")
+	graphCmd.PersistentFlags().StringVar(&GraphFormat, "format", "text", "Output format, text or dot")
+	_ = graphCmd.MarkPersistentFlagRequired("config")
+
 	rootCmd.AddCommand(validateConfigCmd)
+	rootCmd.AddCommand(graphCmd)
 
 	err := rootCmd.Execute()
 	if err != nil {
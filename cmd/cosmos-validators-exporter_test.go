@@ -85,3 +85,43 @@ func TestStartConfigProvided(t *testing.T) {
 
 	main()
 }
+
+//nolint:paralleltest // disabled
+func TestGraphNoConfigProvided(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			require.Fail(t, "Expected to have a panic here!")
+		}
+	}()
+
+	os.Args = []string{"cmd", "graph"}
+
+	main()
+}
+
+//nolint:paralleltest // disabled
+func TestGraphInvalidFormat(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			require.Fail(t, "Expected to have a panic here!")
+		}
+	}()
+
+	os.Args = []string{"cmd", "graph", "--config", "../assets/config-valid.toml", "--format", "svg"}
+
+	main()
+}
+
+//nolint:paralleltest // disabled
+func TestGraphText(_ *testing.T) {
+	os.Args = []string{"cmd", "graph", "--config", "../assets/config-valid.toml"}
+
+	main()
+}
+
+//nolint:paralleltest // disabled
+func TestGraphDot(_ *testing.T) {
+	os.Args = []string{"cmd", "graph", "--config", "../assets/config-valid.toml", "--format", "dot"}
+
+	main()
+}
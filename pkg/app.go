@@ -2,19 +2,27 @@ package pkg
 
 import (
 	"context"
+	"errors"
 	controllerPkg "main/pkg/controller"
 	fetchersPkg "main/pkg/fetchers"
 	"main/pkg/fs"
 	generatorsPkg "main/pkg/generators"
+	statePkg "main/pkg/state"
 	"main/pkg/tendermint"
 	"main/pkg/tracing"
+	"main/pkg/types"
 	"net/http"
+	"os"
+	"slices"
+	"strconv"
+	"sync"
 	"time"
 
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"go.opentelemetry.io/otel/attribute"
 
 	"main/pkg/config"
+	"main/pkg/constants"
 	loggerPkg "main/pkg/logger"
 
 	"github.com/google/uuid"
@@ -41,9 +49,17 @@ type App struct {
 	// metrics based on this data.
 	// Example: ActiveSetTokenGenerator generates a metric
 	// based on ValidatorsFetcher and StakingParamsFetcher.
-	Generators []generatorsPkg.Generator
+	Generators generatorsPkg.Generators
 
 	Controller *controllerPkg.Controller
+	Filesystem fs.FS
+
+	GeneratorErrorsMetrics  *GeneratorErrorsMetrics
+	FetcherExecutionMetrics *FetcherExecutionMetrics
+
+	lastFetchResult  *controllerPkg.FetchResult
+	stateMutex       sync.RWMutex
+	cancelBackground context.CancelFunc
 }
 
 func NewApp(configPath string, filesystem fs.FS, version string) *App {
@@ -77,27 +93,32 @@ func NewApp(configPath string, filesystem fs.FS, version string) *App {
 	}
 
 	fetchers := []fetchersPkg.Fetcher{
-		fetchersPkg.NewSlashingParamsFetcher(logger, appConfig.Chains, rpcs, tracer),
-		fetchersPkg.NewCommissionFetcher(logger, appConfig.Chains, rpcs, tracer),
-		fetchersPkg.NewDelegationsFetcher(logger, appConfig.Chains, rpcs, tracer),
-		fetchersPkg.NewUnbondsFetcher(logger, appConfig.Chains, rpcs, tracer),
-		fetchersPkg.NewSigningInfoFetcher(logger, appConfig.Chains, rpcs, tracer),
-		fetchersPkg.NewRewardsFetcher(logger, appConfig.Chains, rpcs, tracer),
-		fetchersPkg.NewBalanceFetcher(logger, appConfig.Chains, rpcs, tracer),
-		fetchersPkg.NewSelfDelegationFetcher(logger, appConfig.Chains, rpcs, tracer),
-		fetchersPkg.NewValidatorsFetcher(logger, appConfig.Chains, rpcs, tracer),
-		fetchersPkg.NewConsumerValidatorsFetcher(logger, appConfig.Chains, rpcs, tracer),
-		fetchersPkg.NewStakingParamsFetcher(logger, appConfig.Chains, rpcs, tracer),
-		fetchersPkg.NewPriceFetcher(logger, appConfig, tracer),
-		fetchersPkg.NewNodeInfoFetcher(logger, appConfig.Chains, rpcs, tracer),
-		fetchersPkg.NewConsumerInfoFetcher(logger, appConfig.Chains, rpcs, tracer),
-		fetchersPkg.NewValidatorConsumersFetcher(logger, appConfig.Chains, rpcs, tracer),
-		fetchersPkg.NewConsumerCommissionFetcher(logger, appConfig.Chains, rpcs, tracer),
-		fetchersPkg.NewInflationFetcher(logger, appConfig.Chains, rpcs, tracer),
-		fetchersPkg.NewSupplyFetcher(logger, appConfig.Chains, rpcs, tracer),
-	}
-
-	generators := []generatorsPkg.Generator{
+		fetchersPkg.Typed(fetchersPkg.NewSlashingParamsFetcher(logger, appConfig.Chains, rpcs, tracer)),
+		fetchersPkg.Typed(fetchersPkg.NewCommissionFetcher(logger, appConfig.Chains, rpcs, tracer)),
+		fetchersPkg.Typed(fetchersPkg.NewDelegationsFetcher(logger, appConfig.Chains, rpcs, tracer)),
+		fetchersPkg.Typed(fetchersPkg.NewUnbondsFetcher(logger, appConfig.Chains, rpcs, tracer)),
+		fetchersPkg.Typed(fetchersPkg.NewSigningInfoFetcher(logger, appConfig.Chains, rpcs, tracer)),
+		fetchersPkg.Typed(fetchersPkg.NewRewardsFetcher(logger, appConfig.Chains, rpcs, tracer)),
+		fetchersPkg.Typed(fetchersPkg.NewBalanceFetcher(logger, appConfig.Chains, rpcs, tracer)),
+		fetchersPkg.Typed(fetchersPkg.NewSelfDelegationFetcher(logger, appConfig.Chains, rpcs, tracer)),
+		fetchersPkg.Typed(fetchersPkg.NewValidatorsFetcher(logger, appConfig.Chains, rpcs, tracer)),
+		fetchersPkg.Typed(fetchersPkg.NewConsumerValidatorsFetcher(logger, appConfig.Chains, rpcs, tracer)),
+		fetchersPkg.Typed(fetchersPkg.NewStakingParamsFetcher(logger, appConfig.Chains, rpcs, tracer)),
+		fetchersPkg.Typed(fetchersPkg.NewPriceFetcher(logger, appConfig, tracer)),
+		fetchersPkg.Typed(fetchersPkg.NewNodeInfoFetcher(logger, appConfig.Chains, rpcs, tracer)),
+		fetchersPkg.Typed(fetchersPkg.NewConsumerInfoFetcher(logger, appConfig.Chains, rpcs, tracer)),
+		fetchersPkg.Typed(fetchersPkg.NewValidatorConsumersFetcher(logger, appConfig.Chains, rpcs, tracer)),
+		fetchersPkg.Typed(fetchersPkg.NewConsumerCommissionFetcher(logger, appConfig.Chains, rpcs, tracer)),
+		fetchersPkg.Typed(fetchersPkg.NewInflationFetcher(logger, appConfig.Chains, rpcs, tracer)),
+		fetchersPkg.Typed(fetchersPkg.NewSupplyFetcher(logger, appConfig.Chains, rpcs, tracer)),
+		fetchersPkg.Typed(fetchersPkg.NewGovernanceFetcher(logger, appConfig.Chains, rpcs, tracer)),
+		fetchersPkg.Typed(fetchersPkg.NewRecentBlocksFetcher(logger, appConfig.Chains, rpcs, tracer)),
+		fetchersPkg.Typed(fetchersPkg.NewProposersFetcher(logger, appConfig.Chains, rpcs, tracer)),
+		fetchersPkg.Typed(fetchersPkg.NewUpgradePlanFetcher(logger, appConfig.Chains, rpcs, tracer)),
+		fetchersPkg.Typed(fetchersPkg.NewMissedBlocksRateFetcher(logger)),
+	}
+
+	allGenerators := generatorsPkg.Generators{
 		generatorsPkg.NewSlashingParamsGenerator(),
 		generatorsPkg.NewIsConsumerGenerator(appConfig.Chains),
 		generatorsPkg.NewUptimeGenerator(),
@@ -121,9 +142,35 @@ func NewApp(configPath string, filesystem fs.FS, version string) *App {
 		generatorsPkg.NewValidatorCommissionRateGenerator(appConfig.Chains, logger),
 		generatorsPkg.NewInflationGenerator(),
 		generatorsPkg.NewSupplyGenerator(appConfig.Chains),
+		generatorsPkg.NewGovernanceGenerator(),
+		generatorsPkg.NewRecentBlocksGenerator(),
+		generatorsPkg.NewProposersGenerator(appConfig.Chains, logger),
+		generatorsPkg.NewUpgradePlanGenerator(),
+		generatorsPkg.NewJailRiskGenerator(),
+	}
+
+	generatorNames := allGenerators.GetNames()
+
+	for _, generatorName := range appConfig.DisabledGenerators {
+		if !slices.Contains(generatorNames, generatorName) {
+			logger.Warn().
+				Str("name", string(generatorName)).
+				Msg("Disabled generator does not exist, ignoring it.")
+		}
 	}
 
-	controller := controllerPkg.NewController(fetchers, logger)
+	generators := allGenerators.WithoutDisabled(appConfig.DisabledGenerators)
+
+	controller, err := controllerPkg.NewController(
+		fetchers,
+		generators.GetFetchers(),
+		appConfig.FetchersConfig.RefreshIntervals(),
+		logger,
+		tracer,
+	)
+	if err != nil {
+		logger.Panic().Err(err).Msg("Invalid fetchers dependencies")
+	}
 
 	server := &http.Server{Addr: appConfig.ListenAddress, Handler: nil}
 
@@ -136,6 +183,10 @@ func NewApp(configPath string, filesystem fs.FS, version string) *App {
 		Generators: generators,
 		Server:     server,
 		Controller: controller,
+		Filesystem: filesystem,
+
+		GeneratorErrorsMetrics:  NewGeneratorErrorsMetrics(generators.GetNames()),
+		FetcherExecutionMetrics: NewFetcherExecutionMetrics(controller.Fetchers.GetNames()),
 	}
 }
 
@@ -146,10 +197,23 @@ func (a *App) Start() {
 	handler.HandleFunc("/healthcheck", a.Healthcheck)
 	a.Server.Handler = handler
 
+	a.LoadSnapshot()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	a.cancelBackground = cancel
+
+	if a.Config.ScrapeInterval > 0 {
+		go a.RunBackgroundFetch(ctx, time.Duration(a.Config.ScrapeInterval)*time.Second)
+	}
+
+	if a.Config.SnapshotPath != "" && a.Config.SnapshotInterval > 0 {
+		go a.RunSnapshotSaving(ctx, time.Duration(a.Config.SnapshotInterval)*time.Second)
+	}
+
 	a.Logger.Info().Str("addr", a.Config.ListenAddress).Msg("Listening")
 
 	err := a.Server.ListenAndServe()
-	if err != nil {
+	if err != nil && !errors.Is(err, http.ErrServerClosed) {
 		a.Logger.Panic().Err(err).Msg("Could not start application")
 	}
 }
@@ -157,6 +221,14 @@ func (a *App) Start() {
 func (a *App) Stop() {
 	a.Logger.Info().Str("addr", a.Config.ListenAddress).Msg("Shutting down server...")
 
+	if a.cancelBackground != nil {
+		a.cancelBackground()
+	}
+
+	if a.Config.SnapshotPath != "" {
+		a.SaveSnapshot()
+	}
+
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
@@ -181,16 +253,39 @@ func (a *App) Handler(w http.ResponseWriter, r *http.Request) {
 
 	registry := prometheus.NewRegistry()
 
-	state, queryInfos := a.Controller.Fetch(rootSpanCtx)
+	fetchCtx := rootSpanCtx
+
+	if scrapeTimeout := a.GetScrapeTimeout(r); scrapeTimeout > 0 {
+		var cancel context.CancelFunc
+		fetchCtx, cancel = context.WithTimeout(rootSpanCtx, scrapeTimeout)
+		defer cancel()
+	}
+
+	fetchResult := a.GetFetchResult(fetchCtx)
+	state := fetchResult.State
 
-	queriesMetrics := NewQueriesMetrics(a.Config.Chains, queryInfos)
+	queriesMetrics := NewQueriesMetrics(a.Config.Chains, fetchResult.QueryInfos)
 	registry.MustRegister(queriesMetrics.GetMetrics(rootSpanCtx)...)
 
+	dataAgeMetrics := NewDataAgeMetrics(a.Controller.GetLastSuccessTimes())
+	registry.MustRegister(dataAgeMetrics.GetMetrics()...)
+
+	endpointsMetrics := NewEndpointsMetrics(a.RPCs)
+	registry.MustRegister(endpointsMetrics.GetMetrics()...)
+
+	fetchMetrics := NewFetchMetrics(a.Controller.Fetchers.GetNames(), fetchResult.TimedOutFetchers)
+	registry.MustRegister(fetchMetrics.GetMetrics()...)
+
+	pinnedHeightsMetrics := NewPinnedHeightsMetrics(fetchResult.PinnedHeights)
+	registry.MustRegister(pinnedHeightsMetrics.GetMetrics()...)
+
 	for _, generator := range a.Generators {
-		metrics := generator.Generate(state)
-		registry.MustRegister(metrics...)
+		a.RunGenerator(generator, state, registry, sublogger)
 	}
 
+	registry.MustRegister(a.GeneratorErrorsMetrics.GetMetrics()...)
+	registry.MustRegister(a.FetcherExecutionMetrics.GetMetrics()...)
+
 	h := promhttp.HandlerFor(registry, promhttp.HandlerOpts{})
 	h.ServeHTTP(w, r)
 
@@ -201,6 +296,217 @@ func (a *App) Handler(w http.ResponseWriter, r *http.Request) {
 		Msg("Request processed")
 }
 
+func (a *App) RunGenerator(
+	generator generatorsPkg.Generator,
+	state *statePkg.State,
+	registry *prometheus.Registry,
+	logger zerolog.Logger,
+) {
+	defer func() {
+		if r := recover(); r != nil {
+			logger.Error().
+				Str("generator", string(generator.Name())).
+				Interface("panic", r).
+				Msg("Generator panicked")
+			a.GeneratorErrorsMetrics.Inc(generator.Name())
+		}
+	}()
+
+	metrics := generator.Generate(state)
+
+	for _, metric := range metrics {
+		if err := registry.Register(metric); err != nil {
+			logger.Error().
+				Err(err).
+				Str("generator", string(generator.Name())).
+				Msg("Could not register metric")
+			a.GeneratorErrorsMetrics.Inc(generator.Name())
+		}
+	}
+}
+
+func (a *App) RunBackgroundFetch(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	a.Logger.Info().Dur("interval", interval).Msg("Running fetchers in background")
+
+	for {
+		a.BackgroundFetch(ctx)
+
+		select {
+		case <-ctx.Done():
+			a.Logger.Info().Msg("Stopping background fetching")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (a *App) BackgroundFetch(ctx context.Context) {
+	fetchCtx, span := a.Tracer.Start(ctx, "Background fetch")
+	defer span.End()
+
+	if a.Config.ScrapeTimeout > 0 {
+		var cancel context.CancelFunc
+		fetchCtx, cancel = context.WithTimeout(fetchCtx, time.Duration(a.Config.ScrapeTimeout)*time.Second)
+		defer cancel()
+	}
+
+	fetchStart := time.Now()
+
+	fetchResult := a.Fetch(fetchCtx)
+
+	a.stateMutex.Lock()
+	a.lastFetchResult = fetchResult
+	a.stateMutex.Unlock()
+
+	a.Logger.Debug().
+		Float64("fetch-time", time.Since(fetchStart).Seconds()).
+		Msg("Background fetch finished")
+}
+
+func (a *App) LoadSnapshot() {
+	if a.Config.SnapshotPath == "" {
+		return
+	}
+
+	content, err := a.Filesystem.ReadFile(a.Config.SnapshotPath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			a.Logger.Info().
+				Str("path", a.Config.SnapshotPath).
+				Msg("State snapshot does not exist yet, starting from scratch")
+		} else {
+			a.Logger.Warn().
+				Err(err).
+				Str("path", a.Config.SnapshotPath).
+				Msg("Could not read state snapshot, starting from scratch")
+		}
+
+		return
+	}
+
+	if err := a.Controller.LoadSnapshot(content); err != nil {
+		a.Logger.Warn().
+			Err(err).
+			Str("path", a.Config.SnapshotPath).
+			Msg("Could not load state snapshot, starting from scratch")
+		return
+	}
+
+	a.stateMutex.Lock()
+	a.lastFetchResult = &controllerPkg.FetchResult{
+		State:            a.Controller.GetLastKnownState(),
+		QueryInfos:       []*types.QueryInfo{},
+		TimedOutFetchers: []constants.FetcherName{},
+	}
+	a.stateMutex.Unlock()
+}
+
+func (a *App) SaveSnapshot() {
+	content, err := a.Controller.GetSnapshot()
+	if err != nil {
+		a.Logger.Error().Err(err).Msg("Could not serialize state snapshot")
+		return
+	}
+
+	if err := a.Filesystem.WriteFile(a.Config.SnapshotPath, content); err != nil {
+		a.Logger.Error().
+			Err(err).
+			Str("path", a.Config.SnapshotPath).
+			Msg("Could not write state snapshot")
+		return
+	}
+
+	a.Logger.Debug().Str("path", a.Config.SnapshotPath).Msg("Saved state snapshot")
+}
+
+func (a *App) RunSnapshotSaving(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			a.SaveSnapshot()
+		}
+	}
+}
+
+func (a *App) Fetch(ctx context.Context) *controllerPkg.FetchResult {
+	checkQueries := tendermint.CheckAllEndpoints(ctx, a.RPCs)
+
+	// resolving the heights first, so all the fetchers get the data at the same height
+	pinnedHeights, pinQueries := tendermint.PinHeights(ctx, a.RPCs)
+
+	fetchResult := a.Controller.Fetch(tendermint.WithPinnedHeights(ctx, pinnedHeights))
+	fetchResult.QueryInfos = append(fetchResult.QueryInfos, checkQueries...)
+	fetchResult.QueryInfos = append(fetchResult.QueryInfos, pinQueries...)
+	fetchResult.PinnedHeights = pinnedHeights
+
+	a.FetcherExecutionMetrics.Record(fetchResult.Executions)
+
+	return fetchResult
+}
+
+func (a *App) GetFetchResult(ctx context.Context) *controllerPkg.FetchResult {
+	if a.Config.ScrapeInterval <= 0 {
+		// the restored snapshot is served on the first scrape only
+		a.stateMutex.Lock()
+		restored := a.lastFetchResult
+		a.lastFetchResult = nil
+		a.stateMutex.Unlock()
+
+		if restored != nil {
+			return restored
+		}
+
+		return a.Fetch(ctx)
+	}
+
+	a.stateMutex.RLock()
+	defer a.stateMutex.RUnlock()
+
+	if a.lastFetchResult == nil {
+		return &controllerPkg.FetchResult{
+			State:            statePkg.NewState(),
+			QueryInfos:       []*types.QueryInfo{},
+			TimedOutFetchers: []constants.FetcherName{},
+		}
+	}
+
+	return a.lastFetchResult
+}
+
+func (a *App) GetScrapeTimeout(r *http.Request) time.Duration {
+	timeout := time.Duration(a.Config.ScrapeTimeout) * time.Second
+
+	headerValue := r.Header.Get(constants.HeaderPrometheusScrapeTimeout)
+	if headerValue == "" {
+		return timeout
+	}
+
+	headerSeconds, err := strconv.ParseFloat(headerValue, 64)
+	if err != nil {
+		a.Logger.Warn().
+			Err(err).
+			Str("value", headerValue).
+			Msg("Could not parse Prometheus scrape timeout header")
+
+		return timeout
+	}
+
+	headerTimeout := time.Duration(headerSeconds*float64(time.Second)) - constants.ScrapeTimeoutOffset
+	if headerTimeout > 0 && (timeout <= 0 || headerTimeout < timeout) {
+		return headerTimeout
+	}
+
+	return timeout
+}
+
 func (a *App) Healthcheck(w http.ResponseWriter, r *http.Request) {
 	_, _ = w.Write([]byte("ok"))
 }
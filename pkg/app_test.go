@@ -1,17 +1,58 @@
 package pkg
 
 import (
+	"context"
 	"io"
 	"main/assets"
+	"main/pkg/constants"
+	controllerPkg "main/pkg/controller"
+	fetchersPkg "main/pkg/fetchers"
 	"main/pkg/fs"
+	statePkg "main/pkg/state"
+	"main/pkg/types"
 	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
 	"github.com/jarcoal/httpmock"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
+type PanickingGenerator struct{}
+
+func (g *PanickingGenerator) Name() constants.GeneratorName {
+	return "panicking"
+}
+
+func (g *PanickingGenerator) Fetchers() []constants.FetcherName {
+	return []constants.FetcherName{}
+}
+
+func (g *PanickingGenerator) Generate(state *statePkg.State) []prometheus.Collector {
+	panic("generator error")
+}
+
+type DuplicateMetricsGenerator struct{}
+
+func (g *DuplicateMetricsGenerator) Name() constants.GeneratorName {
+	return "duplicate"
+}
+
+func (g *DuplicateMetricsGenerator) Fetchers() []constants.FetcherName {
+	return []constants.FetcherName{}
+}
+
+func (g *DuplicateMetricsGenerator) Generate(state *statePkg.State) []prometheus.Collector {
+	return []prometheus.Collector{
+		prometheus.NewGauge(prometheus.GaugeOpts{Name: "duplicate", Help: "Duplicate"}),
+		prometheus.NewGauge(prometheus.GaugeOpts{Name: "duplicate", Help: "Duplicate"}),
+	}
+}
+
 //nolint:paralleltest // disabled
 func TestAppLoadConfigError(t *testing.T) {
 	defer func() {
@@ -71,11 +112,8 @@ func TestAppLoadConfigOk(t *testing.T) {
 
 	for {
 		request, err := http.Get("http://localhost:9560/healthcheck")
-		if request.Body != nil {
-			_ = request.Body.Close()
-		}
-
 		if err == nil {
+			_ = request.Body.Close()
 			break
 		}
 
@@ -88,7 +126,7 @@ func TestAppLoadConfigOk(t *testing.T) {
 
 	httpmock.RegisterResponder(
 		"GET",
-		"https://api.cosmos.quokkastake.io/cosmos/staking/v1beta1/validators?pagination.count_total=true&pagination.limit=10000",
+		"https://api.cosmos.quokkastake.io/cosmos/staking/v1beta1/validators?pagination.limit=1000",
 		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("validators.json")),
 	)
 
@@ -160,7 +198,7 @@ func TestAppLoadConfigOk(t *testing.T) {
 
 	httpmock.RegisterResponder(
 		"GET",
-		"https://api.cosmos.quokkastake.io/cosmos/bank/v1beta1/balances/cosmos1xqz9pemz5e5zycaa89kys5aw6m8rhgsvtp9lt2",
+		"https://api.cosmos.quokkastake.io/cosmos/bank/v1beta1/balances/cosmos1xqz9pemz5e5zycaa89kys5aw6m8rhgsvtp9lt2?pagination.limit=1000",
 		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("balances.json")),
 	)
 
@@ -184,7 +222,7 @@ func TestAppLoadConfigOk(t *testing.T) {
 
 	httpmock.RegisterResponder(
 		"GET",
-		"https://api.neutron.quokkastake.io/cosmos/bank/v1beta1/balances/neutron1xqz9pemz5e5zycaa89kys5aw6m8rhgsv07va3d",
+		"https://api.neutron.quokkastake.io/cosmos/bank/v1beta1/balances/neutron1xqz9pemz5e5zycaa89kys5aw6m8rhgsv07va3d?pagination.limit=1000",
 		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("balances.json")),
 	)
 
@@ -232,3 +270,175 @@ func TestAppLoadConfigOk(t *testing.T) {
 	err = response.Body.Close()
 	require.NoError(t, err)
 }
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestAppBackgroundFetch(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	filesystem := &fs.TestFS{}
+
+	app := NewApp("config-background.toml", filesystem, "1.2.3")
+
+	fetchResult := app.GetFetchResult(context.Background())
+	assert.Zero(t, fetchResult.State.Length())
+	assert.Empty(t, fetchResult.QueryInfos)
+
+	app.BackgroundFetch(context.Background())
+
+	fetchResult = app.GetFetchResult(context.Background())
+	assert.Equal(t, len(app.Fetchers), fetchResult.State.Length())
+	assert.NotEmpty(t, fetchResult.QueryInfos)
+
+	recorder := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+	app.Handler(recorder, request)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "cosmos_validators_exporter_queries_total")
+	assert.Contains(t, recorder.Body.String(), "cosmos_validators_exporter_fetcher_duration_seconds_bucket")
+	assert.Contains(t, recorder.Body.String(), "cosmos_validators_exporter_fetcher_runs_total")
+}
+
+//nolint:paralleltest // disabled
+func TestAppGeneratorErrors(t *testing.T) {
+	filesystem := &fs.TestFS{}
+
+	app := NewApp("config-disabled-generators.toml", filesystem, "1.2.3")
+	app.Generators = append(app.Generators, &PanickingGenerator{}, &DuplicateMetricsGenerator{})
+	app.lastFetchResult = &controllerPkg.FetchResult{State: statePkg.NewState()}
+
+	for range 2 {
+		recorder := httptest.NewRecorder()
+		request := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+		app.Handler(recorder, request)
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), "cosmos_validators_exporter_start_time")
+		assert.Contains(t, recorder.Body.String(), "duplicate 0")
+	}
+
+	assert.InDelta(t, 2, testutil.ToFloat64(app.GeneratorErrorsMetrics.ErrorsCounter.With(prometheus.Labels{
+		"generator": "panicking",
+	})), 0.01)
+	assert.InDelta(t, 2, testutil.ToFloat64(app.GeneratorErrorsMetrics.ErrorsCounter.With(prometheus.Labels{
+		"generator": "duplicate",
+	})), 0.01)
+	assert.Zero(t, testutil.ToFloat64(app.GeneratorErrorsMetrics.ErrorsCounter.With(prometheus.Labels{
+		"generator": "uptime",
+	})))
+}
+
+//nolint:paralleltest // disabled
+func TestAppSnapshot(t *testing.T) {
+	filesystem := &fs.TestFS{}
+
+	app := NewApp("config-snapshot.toml", filesystem, "1.2.3")
+
+	// no snapshot yet
+	app.LoadSnapshot()
+	assert.Nil(t, app.lastFetchResult)
+
+	app.Controller.StoreFetchedData(
+		constants.FetcherNameBalance,
+		fetchersPkg.BalanceData{Balances: map[string]map[string][]types.Amount{
+			"cosmos": {"validator": {{Amount: 100, Denom: "uatom"}}},
+		}},
+		[]*types.QueryInfo{{Chain: "cosmos", Success: true}},
+	)
+	app.Stop()
+
+	restartedApp := NewApp("config-snapshot.toml", filesystem, "1.2.3")
+	restartedApp.LoadSnapshot()
+
+	fetchResult := restartedApp.GetFetchResult(context.Background())
+	balances, ok := statePkg.Get(fetchResult.State, fetchersPkg.BalanceKey)
+	require.True(t, ok)
+	assert.Equal(t, []types.Amount{{Amount: 100, Denom: "uatom"}}, balances.Balances["cosmos"]["validator"])
+	assert.Contains(t, restartedApp.Controller.GetLastSuccessTimes()[constants.FetcherNameBalance], "cosmos")
+}
+
+//nolint:paralleltest // disabled
+func TestAppSnapshotServedOnFirstScrape(t *testing.T) {
+	filesystem := &fs.TestFS{}
+
+	app := NewApp("config-snapshot.toml", filesystem, "1.2.3")
+	app.Controller.StoreFetchedData(
+		constants.FetcherNameBalance,
+		fetchersPkg.BalanceData{Balances: map[string]map[string][]types.Amount{
+			"cosmos": {"validator": {{Amount: 100, Denom: "uatom"}}},
+		}},
+		[]*types.QueryInfo{{Chain: "cosmos", Success: true}},
+	)
+	app.Stop()
+
+	restartedApp := NewApp("config-snapshot.toml", filesystem, "1.2.3")
+	restartedApp.Config.ScrapeInterval = 0
+	restartedApp.LoadSnapshot()
+
+	fetchResult := restartedApp.GetFetchResult(context.Background())
+	_, ok := statePkg.Get(fetchResult.State, fetchersPkg.BalanceKey)
+	require.True(t, ok)
+	assert.Nil(t, restartedApp.lastFetchResult)
+}
+
+//nolint:paralleltest // disabled
+func TestAppSnapshotInvalid(t *testing.T) {
+	filesystem := &fs.TestFS{}
+	err := filesystem.WriteFile("snapshot.json", []byte("invalid"))
+	require.NoError(t, err)
+
+	app := NewApp("config-snapshot.toml", filesystem, "1.2.3")
+	app.LoadSnapshot()
+	assert.Nil(t, app.lastFetchResult)
+}
+
+//nolint:paralleltest // disabled
+func TestAppDisabledGenerators(t *testing.T) {
+	filesystem := &fs.TestFS{}
+
+	app := NewApp("config-disabled-generators.toml", filesystem, "1.2.3")
+	assert.Equal(t, []constants.GeneratorName{
+		constants.GeneratorNameUptime,
+		constants.GeneratorNameBalance,
+	}, app.Generators.GetNames())
+	assert.Equal(t, []constants.FetcherName{
+		constants.FetcherNameBalance,
+	}, app.Controller.Fetchers.GetNames())
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestAppRunBackgroundFetchStops(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	filesystem := &fs.TestFS{}
+
+	app := NewApp("config-background.toml", filesystem, "1.2.3")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	app.RunBackgroundFetch(ctx, time.Hour)
+	assert.NotNil(t, app.lastFetchResult)
+}
+
+//nolint:paralleltest // disabled
+func TestAppGetScrapeTimeout(t *testing.T) {
+	filesystem := &fs.TestFS{}
+
+	app := NewApp("config-background.toml", filesystem, "1.2.3")
+
+	request := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+	assert.Equal(t, 20*time.Second, app.GetScrapeTimeout(request))
+
+	request.Header.Set("X-Prometheus-Scrape-Timeout-Seconds", "invalid")
+	assert.Equal(t, 20*time.Second, app.GetScrapeTimeout(request))
+
+	request.Header.Set("X-Prometheus-Scrape-Timeout-Seconds", "10")
+	assert.Equal(t, 9500*time.Millisecond, app.GetScrapeTimeout(request))
+
+	request.Header.Set("X-Prometheus-Scrape-Timeout-Seconds", "60")
+	assert.Equal(t, 20*time.Second, app.GetScrapeTimeout(request))
+
+	app.Config.ScrapeTimeout = 0
+	assert.Equal(t, 59500*time.Millisecond, app.GetScrapeTimeout(request))
+}
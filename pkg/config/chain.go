@@ -3,19 +3,38 @@ package config
 import (
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/guregu/null/v5"
 )
 
+const (
+	DefaultRecentBlocks   = 100
+	DefaultProposerBlocks = 1000
+)
+
 type Chain struct {
-	Name             string      `toml:"name"`
-	LCDEndpoint      string      `toml:"lcd-endpoint"`
-	BaseDenom        string      `toml:"base-denom"`
-	Denoms           DenomInfos  `toml:"denoms"`
-	BechWalletPrefix string      `toml:"bech-wallet-prefix"`
-	Validators       []Validator `toml:"validators"`
-	Queries          Queries     `toml:"queries"`
-	IsProvider       null.Bool   `toml:"is-provider"`
+	Name             string               `toml:"name"`
+	LCDEndpoint      string               `toml:"lcd-endpoint"`
+	LCDEndpoints     []string             `toml:"lcd-endpoints"`
+	RPCEndpoint      string               `toml:"rpc-endpoint"`
+	GRPCEndpoint     string               `toml:"grpc-endpoint"`
+	PinHeight        bool                 `toml:"pin-height"`
+	MaxNodeLag       int                  `toml:"max-node-lag"`
+	RecentBlocks     int                  `toml:"recent-blocks"`
+	ProposerBlocks   int                  `toml:"proposer-blocks"`
+	BaseDenom        string               `toml:"base-denom"`
+	Denoms           DenomInfos           `toml:"denoms"`
+	BechWalletPrefix string               `toml:"bech-wallet-prefix"`
+	Validators       []Validator          `toml:"validators"`
+	Queries          Queries              `toml:"queries"`
+	IsProvider       null.Bool            `toml:"is-provider"`
+	Retries          RetriesConfig        `toml:"retries"`
+	Transport        TransportConfig      `toml:"transport"`
+	RateLimit        RateLimitConfig      `toml:"rate-limit"`
+	CircuitBreaker   CircuitBreakerConfig `toml:"circuit-breaker"`
+	Pagination       PaginationConfig     `toml:"pagination"`
 
 	ConsumerChains []*ConsumerChain `toml:"consumers"`
 }
@@ -24,23 +43,135 @@ func (c *Chain) GetQueries() Queries {
 	return c.Queries
 }
 
-func (c *Chain) GetHost() string {
-	return c.LCDEndpoint
+func (c *Chain) GetHosts() []string {
+	hosts := []string{}
+
+	if c.LCDEndpoint != "" {
+		hosts = append(hosts, c.LCDEndpoint)
+	}
+
+	for _, host := range c.LCDEndpoints {
+		if !slices.Contains(hosts, host) {
+			hosts = append(hosts, host)
+		}
+	}
+
+	return hosts
 }
 
 func (c *Chain) GetName() string {
 	return c.Name
 }
 
+func (c *Chain) GetRPCEndpoint() string {
+	return c.RPCEndpoint
+}
+
+func (c *Chain) GetGRPCEndpoint() string {
+	return c.GRPCEndpoint
+}
+
+func (c *Chain) GetPinHeight() bool {
+	return c.PinHeight
+}
+
+func (c *Chain) GetMaxNodeLag() int {
+	return c.MaxNodeLag
+}
+
+func (c *Chain) GetRecentBlocks() int {
+	if c.RecentBlocks > 0 {
+		return c.RecentBlocks
+	}
+
+	return DefaultRecentBlocks
+}
+
+func (c *Chain) GetProposerBlocks() int {
+	if c.ProposerBlocks > 0 {
+		return c.ProposerBlocks
+	}
+
+	return DefaultProposerBlocks
+}
+
+func (c *Chain) GetRetries() RetriesConfig {
+	return c.Retries
+}
+
+func (c *Chain) GetTransport() TransportConfig {
+	return c.Transport
+}
+
+func (c *Chain) GetRateLimit() RateLimitConfig {
+	return c.RateLimit
+}
+
+func (c *Chain) GetCircuitBreaker() CircuitBreakerConfig {
+	return c.CircuitBreaker
+}
+
+func (c *Chain) GetPagination() PaginationConfig {
+	return c.Pagination
+}
+
 func (c *Chain) Validate() error {
 	if c.Name == "" {
 		return errors.New("empty chain name")
 	}
 
-	if c.LCDEndpoint == "" {
+	if len(c.GetHosts()) == 0 {
 		return errors.New("no LCD endpoint provided")
 	}
 
+	for index, host := range c.LCDEndpoints {
+		if host == "" {
+			return fmt.Errorf("empty LCD endpoint #%d", index)
+		}
+	}
+
+	err := validateGRPCEndpoint(c.GRPCEndpoint)
+	if err != nil {
+		return fmt.Errorf("error in grpc-endpoint: %s", err)
+	}
+
+	if c.MaxNodeLag < 0 {
+		return errors.New("max-node-lag cannot be negative")
+	}
+
+	if c.RecentBlocks < 0 {
+		return errors.New("recent-blocks cannot be negative")
+	}
+
+	if c.ProposerBlocks < 0 {
+		return errors.New("proposer-blocks cannot be negative")
+	}
+
+	err = c.Retries.Validate()
+	if err != nil {
+		return fmt.Errorf("error in retries config: %s", err)
+	}
+
+	err = c.Transport.Validate()
+	if err != nil {
+		return fmt.Errorf("error in transport config: %s", err)
+	}
+
+	err = c.RateLimit.Validate()
+	if err != nil {
+		return fmt.Errorf("error in rate-limit config: %s", err)
+	}
+
+	err = c.CircuitBreaker.Validate()
+	if err != nil {
+		return fmt.Errorf("error in circuit-breaker config: %s", err)
+	}
+
+	err = c.Pagination.Validate()
+	if err != nil {
+		return fmt.Errorf("error in pagination config: %s", err)
+	}
+
 	if len(c.Validators) == 0 {
 		return errors.New("no validators provided")
 	}
@@ -97,3 +228,16 @@ func (c *Chain) DisplayWarnings() []Warning {
 
 	return warnings
 }
+
+// validateGRPCEndpoint requires a scheme, as it defines whether to use TLS.
+func validateGRPCEndpoint(endpoint string) error {
+	if endpoint == "" {
+		return nil
+	}
+
+	if !strings.HasPrefix(endpoint, "http://") && !strings.HasPrefix(endpoint, "https://") {
+		return errors.New("should start with http:// or https://")
+	}
+
+	return nil
+}
@@ -2,6 +2,17 @@ package config
 
 type ChainInfo interface {
 	GetQueries() Queries
-	GetHost() string
+	GetHosts() []string
 	GetName() string
+	GetRPCEndpoint() string
+	GetGRPCEndpoint() string
+	GetPinHeight() bool
+	GetMaxNodeLag() int
+	GetRecentBlocks() int
+	GetProposerBlocks() int
+	GetRetries() RetriesConfig
+	GetTransport() TransportConfig
+	GetRateLimit() RateLimitConfig
+	GetCircuitBreaker() CircuitBreakerConfig
+	GetPagination() PaginationConfig
 }
@@ -16,11 +16,82 @@ func TestChainMethods(t *testing.T) {
 		Queries:     map[string]bool{"enabled": true},
 	}
 
-	assert.Equal(t, "example", chain.GetHost())
+	assert.Equal(t, []string{"example"}, chain.GetHosts())
 	assert.Equal(t, "chain", chain.GetName())
 	assert.Len(t, chain.GetQueries(), 1)
 }
 
+func TestChainGetHostsMultiple(t *testing.T) {
+	t.Parallel()
+
+	chain := Chain{
+		LCDEndpoint:  "first",
+		LCDEndpoints: []string{"second", "first", "third"},
+	}
+
+	assert.Equal(t, []string{"first", "second", "third"}, chain.GetHosts())
+}
+
+func TestChainValidateEmptyEndpoint(t *testing.T) {
+	t.Parallel()
+
+	chain := Chain{Name: "test", LCDEndpoints: []string{"test", ""}}
+	err := chain.Validate()
+	require.Error(t, err)
+}
+
+func TestChainValidateInvalidGRPCEndpoint(t *testing.T) {
+	t.Parallel()
+
+	chain := Chain{Name: "test", LCDEndpoint: "test", GRPCEndpoint: "localhost:9090"}
+	err := chain.Validate()
+	require.Error(t, err)
+}
+
+func TestChainValidateNegativeMaxNodeLag(t *testing.T) {
+	t.Parallel()
+
+	chain := Chain{Name: "test", LCDEndpoint: "test", MaxNodeLag: -1}
+	err := chain.Validate()
+	require.Error(t, err)
+}
+
+func TestChainValidateNegativeRecentBlocks(t *testing.T) {
+	t.Parallel()
+
+	chain := Chain{Name: "test", LCDEndpoint: "test", RecentBlocks: -1}
+	err := chain.Validate()
+	require.Error(t, err)
+}
+
+func TestChainGetRecentBlocks(t *testing.T) {
+	t.Parallel()
+
+	chain := Chain{}
+	assert.Equal(t, DefaultRecentBlocks, chain.GetRecentBlocks())
+
+	chain.RecentBlocks = 10
+	assert.Equal(t, 10, chain.GetRecentBlocks())
+}
+
+func TestChainValidateNegativeProposerBlocks(t *testing.T) {
+	t.Parallel()
+
+	chain := Chain{Name: "test", LCDEndpoint: "test", ProposerBlocks: -1}
+	err := chain.Validate()
+	require.Error(t, err)
+}
+
+func TestChainGetProposerBlocks(t *testing.T) {
+	t.Parallel()
+
+	chain := Chain{}
+	assert.Equal(t, DefaultProposerBlocks, chain.GetProposerBlocks())
+
+	chain.ProposerBlocks = 10
+	assert.Equal(t, 10, chain.GetProposerBlocks())
+}
+
 func TestChainValidateNoName(t *testing.T) {
 	t.Parallel()
 
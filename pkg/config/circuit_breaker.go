@@ -0,0 +1,24 @@
+package config
+
+import "errors"
+
+type CircuitBreakerConfig struct {
+	FailureThreshold int `toml:"failure-threshold"`
+	CoolDown         int `default:"60" toml:"cool-down"`
+}
+
+func (c CircuitBreakerConfig) Validate() error {
+	if c.FailureThreshold < 0 {
+		return errors.New("failure-threshold cannot be negative")
+	}
+
+	if c.CoolDown < 0 {
+		return errors.New("cool-down cannot be negative")
+	}
+
+	if c.FailureThreshold > 0 && c.CoolDown == 0 {
+		return errors.New("cool-down should be set if failure-threshold is set")
+	}
+
+	return nil
+}
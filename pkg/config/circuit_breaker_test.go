@@ -0,0 +1,22 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCircuitBreakerConfigInvalid(t *testing.T) {
+	t.Parallel()
+
+	require.Error(t, CircuitBreakerConfig{FailureThreshold: -1}.Validate())
+	require.Error(t, CircuitBreakerConfig{CoolDown: -1}.Validate())
+	require.Error(t, CircuitBreakerConfig{FailureThreshold: 5}.Validate())
+}
+
+func TestCircuitBreakerConfigValid(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, CircuitBreakerConfig{}.Validate())
+	require.NoError(t, CircuitBreakerConfig{FailureThreshold: 5, CoolDown: 60}.Validate())
+}
@@ -3,6 +3,7 @@ package config
 import (
 	"errors"
 	"fmt"
+	"main/pkg/constants"
 	"main/pkg/fs"
 
 	"github.com/BurntSushi/toml"
@@ -10,11 +11,17 @@ import (
 )
 
 type Config struct {
-	LogConfig     LogConfig     `toml:"log"`
-	TracingConfig TracingConfig `toml:"tracing"`
-	ListenAddress string        `default:":9560" toml:"listen-address"`
-	Timeout       int           `default:"10"    toml:"timeout"`
-	Chains        []*Chain      `toml:"chains"`
+	LogConfig          LogConfig                 `toml:"log"`
+	TracingConfig      TracingConfig             `toml:"tracing"`
+	ListenAddress      string                    `default:":9560" toml:"listen-address"`
+	Timeout            int                       `default:"10"    toml:"timeout"`
+	ScrapeInterval     int                       `default:"0"     toml:"scrape-interval"`
+	ScrapeTimeout      int                       `default:"0"     toml:"scrape-timeout"`
+	SnapshotPath       string                    `default:""      toml:"snapshot-path"`
+	SnapshotInterval   int                       `default:"60"    toml:"snapshot-interval"`
+	DisabledGenerators []constants.GeneratorName `toml:"disabled-generators"`
+	Chains             []*Chain                  `toml:"chains"`
+	FetchersConfig     FetchersConfig            `toml:"fetchers"`
 }
 
 type LogConfig struct {
@@ -28,6 +35,23 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("error in tracing config: %s", err)
 	}
 
+	err = c.FetchersConfig.Validate()
+	if err != nil {
+		return fmt.Errorf("error in fetchers config: %s", err)
+	}
+
+	if c.ScrapeInterval < 0 {
+		return errors.New("scrape-interval cannot be negative")
+	}
+
+	if c.ScrapeTimeout < 0 {
+		return errors.New("scrape-timeout cannot be negative")
+	}
+
+	if c.SnapshotInterval < 0 {
+		return errors.New("snapshot-interval cannot be negative")
+	}
+
 	if len(c.Chains) == 0 {
 		return errors.New("no chains provided")
 	}
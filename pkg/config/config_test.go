@@ -28,6 +28,57 @@ func TestConfigValidateNoChains(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestConfigValidateNegativeScrapeInterval(t *testing.T) {
+	t.Parallel()
+
+	config := Config{
+		ScrapeInterval: -1,
+		Chains: []*Chain{{
+			Name:        "chain",
+			LCDEndpoint: "test",
+			BaseDenom:   "denom",
+			Validators:  []Validator{{Address: "test"}},
+		}},
+	}
+
+	err := config.Validate()
+	require.Error(t, err)
+}
+
+func TestConfigValidateNegativeScrapeTimeout(t *testing.T) {
+	t.Parallel()
+
+	config := Config{
+		ScrapeTimeout: -1,
+		Chains: []*Chain{{
+			Name:        "chain",
+			LCDEndpoint: "test",
+			BaseDenom:   "denom",
+			Validators:  []Validator{{Address: "test"}},
+		}},
+	}
+
+	err := config.Validate()
+	require.Error(t, err)
+}
+
+func TestConfigValidateNegativeSnapshotInterval(t *testing.T) {
+	t.Parallel()
+
+	config := Config{
+		SnapshotInterval: -1,
+		Chains: []*Chain{{
+			Name:        "chain",
+			LCDEndpoint: "test",
+			BaseDenom:   "denom",
+			Validators:  []Validator{{Address: "test"}},
+		}},
+	}
+
+	err := config.Validate()
+	require.Error(t, err)
+}
+
 func TestConfigValidateInvalidChain(t *testing.T) {
 	t.Parallel()
 
@@ -112,3 +163,18 @@ func TestLoadConfigValid(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, config)
 }
+
+func TestLoadConfigDefaults(t *testing.T) {
+	t.Parallel()
+
+	filesystem := &fs.TestFS{}
+	config, err := GetConfig("config-valid.toml", filesystem)
+	require.NoError(t, err)
+	require.NotEmpty(t, config.Chains)
+	require.Equal(t, RetriesConfig{
+		MaxRetries:   2,
+		BackoffMs:    500,
+		MaxBackoffMs: 5000,
+	}, config.Chains[0].GetRetries())
+	require.Equal(t, 60, config.Chains[0].GetCircuitBreaker().CoolDown)
+}
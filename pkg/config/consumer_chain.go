@@ -3,41 +3,157 @@ package config
 import (
 	"errors"
 	"fmt"
+	"slices"
 )
 
 type ConsumerChain struct {
-	Name                string     `toml:"name"`
-	LCDEndpoint         string     `toml:"lcd-endpoint"`
-	BaseDenom           string     `toml:"base-denom"`
-	Denoms              DenomInfos `toml:"denoms"`
-	ConsumerID          string     `toml:"consumer-id"`
-	BechWalletPrefix    string     `toml:"bech-wallet-prefix"`
-	BechValidatorPrefix string     `toml:"bech-validator-prefix"`
-	BechConsensusPrefix string     `toml:"bech-consensus-prefix"`
-	Queries             Queries    `toml:"queries"`
+	Name                string               `toml:"name"`
+	LCDEndpoint         string               `toml:"lcd-endpoint"`
+	LCDEndpoints        []string             `toml:"lcd-endpoints"`
+	RPCEndpoint         string               `toml:"rpc-endpoint"`
+	GRPCEndpoint        string               `toml:"grpc-endpoint"`
+	PinHeight           bool                 `toml:"pin-height"`
+	MaxNodeLag          int                  `toml:"max-node-lag"`
+	RecentBlocks        int                  `toml:"recent-blocks"`
+	BaseDenom           string               `toml:"base-denom"`
+	Denoms              DenomInfos           `toml:"denoms"`
+	ConsumerID          string               `toml:"consumer-id"`
+	BechWalletPrefix    string               `toml:"bech-wallet-prefix"`
+	BechValidatorPrefix string               `toml:"bech-validator-prefix"`
+	BechConsensusPrefix string               `toml:"bech-consensus-prefix"`
+	Queries             Queries              `toml:"queries"`
+	Retries             RetriesConfig        `toml:"retries"`
+	Transport           TransportConfig      `toml:"transport"`
+	RateLimit           RateLimitConfig      `toml:"rate-limit"`
+	CircuitBreaker      CircuitBreakerConfig `toml:"circuit-breaker"`
+	Pagination          PaginationConfig     `toml:"pagination"`
 }
 
 func (c *ConsumerChain) GetQueries() Queries {
 	return c.Queries
 }
 
-func (c *ConsumerChain) GetHost() string {
-	return c.LCDEndpoint
+func (c *ConsumerChain) GetHosts() []string {
+	hosts := []string{}
+
+	if c.LCDEndpoint != "" {
+		hosts = append(hosts, c.LCDEndpoint)
+	}
+
+	for _, host := range c.LCDEndpoints {
+		if !slices.Contains(hosts, host) {
+			hosts = append(hosts, host)
+		}
+	}
+
+	return hosts
 }
 
 func (c *ConsumerChain) GetName() string {
 	return c.Name
 }
 
+func (c *ConsumerChain) GetRPCEndpoint() string {
+	return c.RPCEndpoint
+}
+
+func (c *ConsumerChain) GetGRPCEndpoint() string {
+	return c.GRPCEndpoint
+}
+
+func (c *ConsumerChain) GetPinHeight() bool {
+	return c.PinHeight
+}
+
+func (c *ConsumerChain) GetMaxNodeLag() int {
+	return c.MaxNodeLag
+}
+
+func (c *ConsumerChain) GetRecentBlocks() int {
+	if c.RecentBlocks > 0 {
+		return c.RecentBlocks
+	}
+
+	return DefaultRecentBlocks
+}
+
+func (c *ConsumerChain) GetProposerBlocks() int {
+	return DefaultProposerBlocks
+}
+
+func (c *ConsumerChain) GetRetries() RetriesConfig {
+	return c.Retries
+}
+
+func (c *ConsumerChain) GetTransport() TransportConfig {
+	return c.Transport
+}
+
+func (c *ConsumerChain) GetRateLimit() RateLimitConfig {
+	return c.RateLimit
+}
+
+func (c *ConsumerChain) GetCircuitBreaker() CircuitBreakerConfig {
+	return c.CircuitBreaker
+}
+
+func (c *ConsumerChain) GetPagination() PaginationConfig {
+	return c.Pagination
+}
+
 func (c *ConsumerChain) Validate() error {
 	if c.Name == "" {
 		return errors.New("empty chain name")
 	}
 
-	if c.LCDEndpoint == "" {
+	if len(c.GetHosts()) == 0 {
 		return errors.New("no LCD endpoint provided")
 	}
 
+	for index, host := range c.LCDEndpoints {
+		if host == "" {
+			return fmt.Errorf("empty LCD endpoint #%d", index)
+		}
+	}
+
+	err := validateGRPCEndpoint(c.GRPCEndpoint)
+	if err != nil {
+		return fmt.Errorf("error in grpc-endpoint: %s", err)
+	}
+
+	if c.MaxNodeLag < 0 {
+		return errors.New("max-node-lag cannot be negative")
+	}
+
+	if c.RecentBlocks < 0 {
+		return errors.New("recent-blocks cannot be negative")
+	}
+
+	err = c.Retries.Validate()
+	if err != nil {
+		return fmt.Errorf("error in retries config: %s", err)
+	}
+
+	err = c.Transport.Validate()
+	if err != nil {
+		return fmt.Errorf("error in transport config: %s", err)
+	}
+
+	err = c.RateLimit.Validate()
+	if err != nil {
+		return fmt.Errorf("error in rate-limit config: %s", err)
+	}
+
+	err = c.CircuitBreaker.Validate()
+	if err != nil {
+		return fmt.Errorf("error in circuit-breaker config: %s", err)
+	}
+
+	err = c.Pagination.Validate()
+	if err != nil {
+		return fmt.Errorf("error in pagination config: %s", err)
+	}
+
 	if c.ConsumerID == "" {
 		return errors.New("no consumer-id provided")
 	}
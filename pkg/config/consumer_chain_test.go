@@ -16,11 +16,71 @@ func TestConsumerChainMethods(t *testing.T) {
 		Queries:     map[string]bool{"enabled": true},
 	}
 
-	assert.Equal(t, "example", chain.GetHost())
+	assert.Equal(t, []string{"example"}, chain.GetHosts())
 	assert.Equal(t, "chain", chain.GetName())
 	assert.Len(t, chain.GetQueries(), 1)
 }
 
+func TestConsumerChainGetHostsMultiple(t *testing.T) {
+	t.Parallel()
+
+	chain := ConsumerChain{
+		LCDEndpoint:  "first",
+		LCDEndpoints: []string{"second", "first", "third"},
+	}
+
+	assert.Equal(t, []string{"first", "second", "third"}, chain.GetHosts())
+}
+
+func TestConsumerChainValidateEmptyEndpoint(t *testing.T) {
+	t.Parallel()
+
+	chain := ConsumerChain{Name: "test", LCDEndpoints: []string{"test", ""}, ConsumerID: "0"}
+	err := chain.Validate()
+	require.Error(t, err)
+}
+
+func TestConsumerChainValidateInvalidGRPCEndpoint(t *testing.T) {
+	t.Parallel()
+
+	chain := ConsumerChain{Name: "test", LCDEndpoint: "test", GRPCEndpoint: "localhost:9090"}
+	err := chain.Validate()
+	require.Error(t, err)
+}
+
+func TestConsumerChainValidateNegativeMaxNodeLag(t *testing.T) {
+	t.Parallel()
+
+	chain := ConsumerChain{Name: "test", LCDEndpoint: "test", MaxNodeLag: -1}
+	err := chain.Validate()
+	require.Error(t, err)
+}
+
+func TestConsumerChainValidateNegativeRecentBlocks(t *testing.T) {
+	t.Parallel()
+
+	chain := ConsumerChain{Name: "test", LCDEndpoint: "test", RecentBlocks: -1}
+	err := chain.Validate()
+	require.Error(t, err)
+}
+
+func TestConsumerChainGetRecentBlocks(t *testing.T) {
+	t.Parallel()
+
+	chain := ConsumerChain{}
+	assert.Equal(t, DefaultRecentBlocks, chain.GetRecentBlocks())
+
+	chain.RecentBlocks = 10
+	assert.Equal(t, 10, chain.GetRecentBlocks())
+}
+
+func TestConsumerChainGetProposerBlocks(t *testing.T) {
+	t.Parallel()
+
+	chain := ConsumerChain{}
+	assert.Equal(t, DefaultProposerBlocks, chain.GetProposerBlocks())
+}
+
 func TestConsumerChainValidateNoName(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,35 @@
+package config
+
+import (
+	"fmt"
+	"main/pkg/constants"
+	"time"
+)
+
+type FetcherConfig struct {
+	RefreshInterval int `toml:"refresh-interval"`
+}
+
+type FetchersConfig map[constants.FetcherName]FetcherConfig
+
+func (c FetchersConfig) Validate() error {
+	for name, fetcherConfig := range c {
+		if fetcherConfig.RefreshInterval < 0 {
+			return fmt.Errorf("refresh-interval for fetcher %s cannot be negative", name)
+		}
+	}
+
+	return nil
+}
+
+func (c FetchersConfig) RefreshIntervals() map[constants.FetcherName]time.Duration {
+	intervals := make(map[constants.FetcherName]time.Duration, len(c))
+
+	for name, fetcherConfig := range c {
+		if fetcherConfig.RefreshInterval > 0 {
+			intervals[name] = time.Duration(fetcherConfig.RefreshInterval) * time.Second
+		}
+	}
+
+	return intervals
+}
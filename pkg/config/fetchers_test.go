@@ -0,0 +1,45 @@
+package config
+
+import (
+	"main/pkg/constants"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFetchersConfigValidateInvalid(t *testing.T) {
+	t.Parallel()
+
+	config := FetchersConfig{
+		constants.FetcherNameValidators: {RefreshInterval: -1},
+	}
+
+	err := config.Validate()
+	require.Error(t, err)
+}
+
+func TestFetchersConfigValidateValid(t *testing.T) {
+	t.Parallel()
+
+	config := FetchersConfig{
+		constants.FetcherNameValidators: {RefreshInterval: 60},
+	}
+
+	err := config.Validate()
+	require.NoError(t, err)
+}
+
+func TestFetchersConfigRefreshIntervals(t *testing.T) {
+	t.Parallel()
+
+	config := FetchersConfig{
+		constants.FetcherNameValidators:    {RefreshInterval: 60},
+		constants.FetcherNameStakingParams: {RefreshInterval: 0},
+	}
+
+	intervals := config.RefreshIntervals()
+	assert.Len(t, intervals, 1)
+	assert.Equal(t, time.Minute, intervals[constants.FetcherNameValidators])
+}
@@ -0,0 +1,42 @@
+package config
+
+import "errors"
+
+const (
+	DefaultPageSize = 1000
+	DefaultMaxPages = 100
+)
+
+type PaginationConfig struct {
+	PageSize int `toml:"page-size"`
+	MaxPages int `toml:"max-pages"`
+}
+
+func (c PaginationConfig) Validate() error {
+	if c.PageSize < 0 {
+		return errors.New("page-size cannot be negative")
+	}
+
+	if c.MaxPages < 0 {
+		return errors.New("max-pages cannot be negative")
+	}
+
+	return nil
+}
+
+func (c PaginationConfig) GetPageSize() int {
+	if c.PageSize > 0 {
+		return c.PageSize
+	}
+
+	return DefaultPageSize
+}
+
+// GetMaxPages keeps a node returning the same next_key from looping forever.
+func (c PaginationConfig) GetMaxPages() int {
+	if c.MaxPages > 0 {
+		return c.MaxPages
+	}
+
+	return DefaultMaxPages
+}
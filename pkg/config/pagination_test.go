@@ -0,0 +1,25 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPaginationConfigInvalid(t *testing.T) {
+	t.Parallel()
+
+	require.Error(t, PaginationConfig{PageSize: -1}.Validate())
+	require.Error(t, PaginationConfig{MaxPages: -1}.Validate())
+}
+
+func TestPaginationConfigDefaults(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, PaginationConfig{}.Validate())
+	assert.Equal(t, DefaultPageSize, PaginationConfig{}.GetPageSize())
+	assert.Equal(t, DefaultMaxPages, PaginationConfig{}.GetMaxPages())
+	assert.Equal(t, 100, PaginationConfig{PageSize: 100}.GetPageSize())
+	assert.Equal(t, 5, PaginationConfig{MaxPages: 5}.GetMaxPages())
+}
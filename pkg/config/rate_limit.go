@@ -0,0 +1,40 @@
+package config
+
+import (
+	"errors"
+	"math"
+)
+
+type RateLimitConfig struct {
+	RequestsPerSecond float64 `toml:"requests-per-second"`
+	Burst             int     `toml:"burst"`
+	MaxInFlight       int     `toml:"max-in-flight"`
+}
+
+func (c RateLimitConfig) Validate() error {
+	if c.RequestsPerSecond < 0 {
+		return errors.New("requests-per-second cannot be negative")
+	}
+
+	if c.Burst < 0 {
+		return errors.New("burst cannot be negative")
+	}
+
+	if c.Burst > 0 && c.RequestsPerSecond == 0 {
+		return errors.New("burst is set, but requests-per-second is not")
+	}
+
+	if c.MaxInFlight < 0 {
+		return errors.New("max-in-flight cannot be negative")
+	}
+
+	return nil
+}
+
+func (c RateLimitConfig) GetBurst() int {
+	if c.Burst > 0 {
+		return c.Burst
+	}
+
+	return max(int(math.Ceil(c.RequestsPerSecond)), 1)
+}
@@ -0,0 +1,32 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimitConfigInvalid(t *testing.T) {
+	t.Parallel()
+
+	require.Error(t, RateLimitConfig{RequestsPerSecond: -1}.Validate())
+	require.Error(t, RateLimitConfig{RequestsPerSecond: 1, Burst: -1}.Validate())
+	require.Error(t, RateLimitConfig{Burst: 5}.Validate())
+	require.Error(t, RateLimitConfig{MaxInFlight: -1}.Validate())
+}
+
+func TestRateLimitConfigValid(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, RateLimitConfig{}.Validate())
+	require.NoError(t, RateLimitConfig{RequestsPerSecond: 0.5, Burst: 2, MaxInFlight: 4}.Validate())
+}
+
+func TestRateLimitConfigGetBurst(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, 3, RateLimitConfig{RequestsPerSecond: 10, Burst: 3}.GetBurst())
+	assert.Equal(t, 10, RateLimitConfig{RequestsPerSecond: 10}.GetBurst())
+	assert.Equal(t, 1, RateLimitConfig{RequestsPerSecond: 0.5}.GetBurst())
+}
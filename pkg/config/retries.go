@@ -0,0 +1,25 @@
+package config
+
+import "errors"
+
+type RetriesConfig struct {
+	MaxRetries   int `default:"2"    toml:"max-retries"`
+	BackoffMs    int `default:"500"  toml:"backoff-ms"`
+	MaxBackoffMs int `default:"5000" toml:"max-backoff-ms"`
+}
+
+func (c RetriesConfig) Validate() error {
+	if c.MaxRetries < 0 {
+		return errors.New("max-retries cannot be negative")
+	}
+
+	if c.BackoffMs < 0 {
+		return errors.New("backoff-ms cannot be negative")
+	}
+
+	if c.MaxBackoffMs < c.BackoffMs {
+		return errors.New("max-backoff-ms cannot be less than backoff-ms")
+	}
+
+	return nil
+}
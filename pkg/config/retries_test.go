@@ -0,0 +1,22 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetriesConfigInvalid(t *testing.T) {
+	t.Parallel()
+
+	require.Error(t, RetriesConfig{MaxRetries: -1}.Validate())
+	require.Error(t, RetriesConfig{BackoffMs: -1}.Validate())
+	require.Error(t, RetriesConfig{BackoffMs: 100, MaxBackoffMs: 50}.Validate())
+}
+
+func TestRetriesConfigValid(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, RetriesConfig{}.Validate())
+	require.NoError(t, RetriesConfig{MaxRetries: 2, BackoffMs: 500, MaxBackoffMs: 5000}.Validate())
+}
@@ -0,0 +1,122 @@
+package config
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+)
+
+type TransportConfig struct {
+	Headers           map[string]string `toml:"headers"`
+	BasicAuthUser     string            `toml:"basic-auth-user"`
+	BasicAuthPassword string            `toml:"basic-auth-password"`
+	BearerToken       string            `toml:"bearer-token"`
+
+	CAFile   string `toml:"ca-file"`
+	CertFile string `toml:"cert-file"`
+	KeyFile  string `toml:"key-file"`
+	ProxyURL string `toml:"proxy-url"`
+
+	Timeout               int `toml:"timeout"`
+	DialTimeout           int `toml:"dial-timeout"`
+	TLSHandshakeTimeout   int `toml:"tls-handshake-timeout"`
+	ResponseHeaderTimeout int `toml:"response-header-timeout"`
+	IdleConnTimeout       int `toml:"idle-conn-timeout"`
+	MaxIdleConns          int `toml:"max-idle-conns"`
+	MaxIdleConnsPerHost   int `toml:"max-idle-conns-per-host"`
+	MaxConnsPerHost       int `toml:"max-conns-per-host"`
+}
+
+func (c TransportConfig) Validate() error {
+	if c.BasicAuthUser != "" && c.BearerToken != "" {
+		return errors.New("cannot use both basic auth and bearer token")
+	}
+
+	if c.BasicAuthUser == "" && c.BasicAuthPassword != "" {
+		return errors.New("basic-auth-password is set, but basic-auth-user is not")
+	}
+
+	if (c.CertFile == "") != (c.KeyFile == "") {
+		return errors.New("cert-file and key-file should be set together")
+	}
+
+	for name, value := range map[string]int{
+		"timeout":                 c.Timeout,
+		"dial-timeout":            c.DialTimeout,
+		"tls-handshake-timeout":   c.TLSHandshakeTimeout,
+		"response-header-timeout": c.ResponseHeaderTimeout,
+		"idle-conn-timeout":       c.IdleConnTimeout,
+		"max-idle-conns":          c.MaxIdleConns,
+		"max-idle-conns-per-host": c.MaxIdleConnsPerHost,
+		"max-conns-per-host":      c.MaxConnsPerHost,
+	} {
+		if value < 0 {
+			return fmt.Errorf("%s cannot be negative", name)
+		}
+	}
+
+	_, err := c.GetProxyURL()
+	if err != nil {
+		return fmt.Errorf("invalid proxy-url: %s", err)
+	}
+
+	_, err = c.GetTLSConfig()
+	if err != nil {
+		return fmt.Errorf("invalid TLS config: %s", err)
+	}
+
+	return nil
+}
+
+func (c TransportConfig) GetProxyURL() (*url.URL, error) {
+	if c.ProxyURL == "" {
+		return nil, nil //nolint:nilnil // no proxy is not an error
+	}
+
+	proxyURL, err := url.Parse(c.ProxyURL)
+	if err != nil {
+		return nil, err
+	}
+
+	if proxyURL.Scheme == "" || proxyURL.Host == "" {
+		return nil, errors.New("proxy URL should have a scheme and a host")
+	}
+
+	return proxyURL, nil
+}
+
+func (c TransportConfig) GetTLSConfig() (*tls.Config, error) {
+	if c.CAFile == "" && c.CertFile == "" {
+		return nil, nil //nolint:nilnil // default TLS config is not an error
+	}
+
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+
+	if c.CAFile != "" {
+		caBytes, err := os.ReadFile(c.CAFile)
+		if err != nil {
+			return nil, err
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caBytes) {
+			return nil, fmt.Errorf("no certificates found in %s", c.CAFile)
+		}
+
+		tlsConfig.RootCAs = pool
+	}
+
+	if c.CertFile != "" {
+		cert, err := tls.LoadX509KeyPair(c.CertFile, c.KeyFile)
+		if err != nil {
+			return nil, err
+		}
+
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
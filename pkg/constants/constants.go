@@ -1,9 +1,17 @@
 package constants
 
+import "time"
+
 type FetcherName string
 
 type PriceFetcherName string
 
+type GeneratorName string
+
+type QueryErrorClass string
+
+type GovVersion string
+
 const (
 	FetcherNameSlashingParams     FetcherName = "slashing-params"
 	FetcherNameCommission         FetcherName = "commission"
@@ -24,14 +32,77 @@ const (
 	FetcherNameNodeInfo           FetcherName = "node_info"
 	FetcherNameInflation          FetcherName = "inflation"
 	FetcherNameSupply             FetcherName = "supply"
+	FetcherNameGovernance         FetcherName = "governance"
+	FetcherNameRecentBlocks       FetcherName = "recent-blocks"
+	FetcherNameProposers          FetcherName = "proposers"
+	FetcherNameUpgradePlan        FetcherName = "upgrade-plan"
+	FetcherNameMissedBlocksRate   FetcherName = "missed-blocks-rate"
 	FetcherNameStub1              FetcherName = "stub1"
 	FetcherNameStub2              FetcherName = "stub2"
 
+	GeneratorNameSlashingParams          GeneratorName = "slashing-params"
+	GeneratorNameIsConsumer              GeneratorName = "is-consumer"
+	GeneratorNameUptime                  GeneratorName = "uptime"
+	GeneratorNameCommission              GeneratorName = "commission"
+	GeneratorNameDelegations             GeneratorName = "delegations"
+	GeneratorNameUnbonds                 GeneratorName = "unbonds"
+	GeneratorNameSigningInfo             GeneratorName = "signing-info"
+	GeneratorNameRewards                 GeneratorName = "rewards"
+	GeneratorNameBalance                 GeneratorName = "balance"
+	GeneratorNameSelfDelegation          GeneratorName = "self-delegation"
+	GeneratorNameValidatorsInfo          GeneratorName = "validators-info"
+	GeneratorNameSingleValidatorInfo     GeneratorName = "single-validator-info"
+	GeneratorNameValidatorRank           GeneratorName = "validator-rank"
+	GeneratorNameActiveSetTokens         GeneratorName = "active-set-tokens"
+	GeneratorNameNodeInfo                GeneratorName = "node-info"
+	GeneratorNameStakingParams           GeneratorName = "staking-params"
+	GeneratorNamePrice                   GeneratorName = "price"
+	GeneratorNameConsumerInfo            GeneratorName = "consumer-info"
+	GeneratorNameConsumerNeedsToSign     GeneratorName = "consumer-needs-to-sign"
+	GeneratorNameValidatorActive         GeneratorName = "validator-active"
+	GeneratorNameValidatorCommissionRate GeneratorName = "validator-commission-rate"
+	GeneratorNameInflation               GeneratorName = "inflation"
+	GeneratorNameSupply                  GeneratorName = "supply"
+	GeneratorNameGovernance              GeneratorName = "governance"
+	GeneratorNameRecentBlocks            GeneratorName = "recent-blocks"
+	GeneratorNameProposers               GeneratorName = "proposers"
+	GeneratorNameUpgradePlan             GeneratorName = "upgrade-plan"
+	GeneratorNameJailRisk                GeneratorName = "jail-risk"
+
+	QueryErrorClassNone         QueryErrorClass = ""
+	QueryErrorClassRequest      QueryErrorClass = "request"
+	QueryErrorClassTimeout      QueryErrorClass = "timeout"
+	QueryErrorClassCanceled     QueryErrorClass = "canceled"
+	QueryErrorClassConnection   QueryErrorClass = "connection"
+	QueryErrorClassRateLimited  QueryErrorClass = "rate_limited"
+	QueryErrorClassServerError  QueryErrorClass = "server_error"
+	QueryErrorClassClientError  QueryErrorClass = "client_error"
+	QueryErrorClassResponseCode QueryErrorClass = "response_code"
+	QueryErrorClassStaleHeight  QueryErrorClass = "stale_height"
+	QueryErrorClassDecode       QueryErrorClass = "decode"
+	QueryErrorClassCircuitOpen  QueryErrorClass = "circuit_open"
+
+	GovVersionV1      GovVersion = "v1"
+	GovVersionV1beta1 GovVersion = "v1beta1"
+
+	GovProposalStatusVotingPeriod = "PROPOSAL_STATUS_VOTING_PERIOD"
+
 	MetricsPrefix string = "cosmos_validators_exporter_"
 
 	ValidatorStatusBonded = "BOND_STATUS_BONDED"
 
-	HeaderBlockHeight = "Grpc-Metadata-X-Cosmos-Block-Height"
+	HeaderBlockHeight       = "Grpc-Metadata-X-Cosmos-Block-Height"
+	GRPCMetadataBlockHeight = "x-cosmos-block-height"
+
+	CometValidatorsPerPage   = 100
+	CometBlockchainMaxBlocks = 20
+	CometMaxParallelQueries  = 10
+
+	BlockTimeEstimateBlocks = 100
+
+	HeaderPrometheusScrapeTimeout = "X-Prometheus-Scrape-Timeout-Seconds"
+	// leaves time to render the metrics before Prometheus gives up the scrape
+	ScrapeTimeoutOffset = 500 * time.Millisecond
 
 	CoingeckoBaseCurrency string = "usd"
 
@@ -2,13 +2,20 @@ package controller
 
 import (
 	"context"
+	"fmt"
 	"main/pkg/constants"
 	fetchersPkg "main/pkg/fetchers"
 	statePkg "main/pkg/state"
 	"main/pkg/types"
+	"main/pkg/utils"
+	"slices"
 	"sync"
+	"time"
 
 	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type FetchersStatuses map[constants.FetcherName]bool
@@ -23,34 +30,239 @@ func (s FetchersStatuses) IsAllDone(fetcherNames []constants.FetcherName) bool {
 	return true
 }
 
+func (s FetchersStatuses) IsAnyTrue(fetcherNames []constants.FetcherName) bool {
+	for _, fetcherName := range fetcherNames {
+		if s[fetcherName] {
+			return true
+		}
+	}
+
+	return false
+}
+
+type FetcherExecution struct {
+	Name       constants.FetcherName
+	Duration   time.Duration
+	QueryInfos []*types.QueryInfo
+}
+
+type FetchResult struct {
+	State            *statePkg.State
+	QueryInfos       []*types.QueryInfo
+	TimedOutFetchers []constants.FetcherName
+	Executions       []FetcherExecution
+	PinnedHeights    map[string]int64
+}
+
+type CachedFetcherData struct {
+	Data      any
+	UpdatedAt time.Time
+}
+
 type Controller struct {
-	Fetchers fetchersPkg.Fetchers
-	Logger   zerolog.Logger
+	Fetchers         fetchersPkg.Fetchers
+	ExecutionPlan    *ExecutionPlan
+	RefreshIntervals map[constants.FetcherName]time.Duration
+	Logger           zerolog.Logger
+	Tracer           trace.Tracer
+
+	cache       map[constants.FetcherName]CachedFetcherData
+	lastSuccess map[constants.FetcherName]map[string]time.Time
+	cacheMutex  sync.Mutex
+
+	lateExecutions      []FetcherExecution
+	lateExecutionsMutex sync.Mutex
 }
 
 func NewController(
 	fetchers fetchersPkg.Fetchers,
+	requiredFetchers []constants.FetcherName,
+	refreshIntervals map[constants.FetcherName]time.Duration,
 	logger *zerolog.Logger,
-) *Controller {
+	tracer trace.Tracer,
+) (*Controller, error) {
+	executionPlan, err := NewExecutionPlan(fetchers)
+	if err != nil {
+		return nil, err
+	}
+
+	fetcherNames := fetchers.GetNames()
+
+	for _, fetcherName := range requiredFetchers {
+		if !slices.Contains(fetcherNames, fetcherName) {
+			return nil, fmt.Errorf("fetcher %s is required, but is not provided", fetcherName)
+		}
+	}
+
+	controllerLogger := logger.With().
+		Str("component", "controller").
+		Logger()
+
+	fetchersToRun := executionPlan.WithDependencies(requiredFetchers)
+	enabledFetchers := fetchersPkg.Fetchers{}
+
+	for _, fetcher := range fetchers {
+		if !slices.Contains(fetchersToRun, fetcher.Name()) {
+			controllerLogger.Debug().
+				Str("name", string(fetcher.Name())).
+				Msg("Fetcher is not used by any enabled generator, skipping it.")
+
+			continue
+		}
+
+		enabledFetchers = append(enabledFetchers, fetcher)
+	}
+
+	// cannot fail, as removing fetchers that nothing depends on keeps the graph valid
+	executionPlan, err = NewExecutionPlan(enabledFetchers)
+	if err != nil {
+		return nil, err
+	}
+
+	for fetcherName := range refreshIntervals {
+		if !slices.Contains(fetcherNames, fetcherName) {
+			controllerLogger.Warn().
+				Str("name", string(fetcherName)).
+				Msg("Refresh interval is set for a fetcher that does not exist, ignoring it.")
+		}
+	}
+
 	return &Controller{
-		Logger: logger.With().
-			Str("component", "controller").
-			Logger(),
-		Fetchers: fetchers,
+		Logger:           controllerLogger,
+		Tracer:           tracer,
+		Fetchers:         enabledFetchers,
+		ExecutionPlan:    executionPlan,
+		RefreshIntervals: refreshIntervals,
+		cache:            map[constants.FetcherName]CachedFetcherData{},
+		lastSuccess:      map[constants.FetcherName]map[string]time.Time{},
+	}, nil
+}
+
+func (c *Controller) GetCachedData(
+	fetcher fetchersPkg.Fetcher,
+	dependenciesRefreshed bool,
+) (any, bool) {
+	interval, ok := c.RefreshIntervals[fetcher.Name()]
+	if !ok || interval <= 0 || dependenciesRefreshed {
+		return nil, false
 	}
+
+	c.cacheMutex.Lock()
+	defer c.cacheMutex.Unlock()
+
+	cached, found := c.cache[fetcher.Name()]
+	if !found || time.Since(cached.UpdatedAt) >= interval {
+		return nil, false
+	}
+
+	return cached.Data, true
+}
+
+func (c *Controller) StoreFetchedData(
+	fetcherName constants.FetcherName,
+	data any,
+	queryInfos []*types.QueryInfo,
+) any {
+	c.cacheMutex.Lock()
+	defer c.cacheMutex.Unlock()
+
+	previous := c.cache[fetcherName]
+
+	chainsSucceeded := map[string]bool{}
+	failedChains := map[string]bool{}
+
+	for _, queryInfo := range queryInfos {
+		if queryInfo == nil {
+			continue
+		}
+
+		succeeded, found := chainsSucceeded[queryInfo.Chain]
+		chainsSucceeded[queryInfo.Chain] = (!found || succeeded) && queryInfo.Success
+
+		if !queryInfo.Success {
+			failedChains[queryInfo.Chain] = true
+		}
+	}
+
+	merged := statePkg.MergeWithPrevious(data, previous.Data, failedChains)
+
+	if _, ok := c.lastSuccess[fetcherName]; !ok {
+		c.lastSuccess[fetcherName] = map[string]time.Time{}
+	}
+
+	now := time.Now()
+	updatedAt := now
+
+	for chain, succeeded := range chainsSucceeded {
+		if succeeded {
+			c.lastSuccess[fetcherName][chain] = now
+		} else {
+			// so the fetcher would be refreshed next time
+			updatedAt = previous.UpdatedAt
+		}
+	}
+
+	c.cache[fetcherName] = CachedFetcherData{
+		Data:      merged,
+		UpdatedAt: updatedAt,
+	}
+
+	return merged
 }
 
-func (c *Controller) Fetch(ctx context.Context) (
-	*statePkg.State,
-	[]*types.QueryInfo,
+func (c *Controller) StoreData(
+	state *statePkg.State,
+	fetcher fetchersPkg.Fetcher,
+	data any,
 ) {
+	if err := fetcher.Store(state, data); err != nil {
+		c.Logger.Error().
+			Err(err).
+			Str("name", string(fetcher.Name())).
+			Msg("Error storing fetcher data")
+	}
+}
+
+func (c *Controller) GetLastSuccessTimes() map[constants.FetcherName]map[string]time.Time {
+	c.cacheMutex.Lock()
+	defer c.cacheMutex.Unlock()
+
+	lastSuccess := make(map[constants.FetcherName]map[string]time.Time, len(c.lastSuccess))
+
+	for fetcherName, chains := range c.lastSuccess {
+		lastSuccess[fetcherName] = make(map[string]time.Time, len(chains))
+		for chain, updatedAt := range chains {
+			lastSuccess[fetcherName][chain] = updatedAt
+		}
+	}
+
+	return lastSuccess
+}
+
+func (c *Controller) GetLastKnownData(fetcherName constants.FetcherName) (any, bool) {
+	c.cacheMutex.Lock()
+	defer c.cacheMutex.Unlock()
+
+	cached, found := c.cache[fetcherName]
+	if !found {
+		return nil, false
+	}
+
+	return cached.Data, true
+}
+
+func (c *Controller) Fetch(ctx context.Context) *FetchResult {
 	data := statePkg.NewState()
 	queries := []*types.QueryInfo{}
+	executions := []FetcherExecution{}
+
 	fetchersStatus := FetchersStatuses{}
 
 	var (
 		mutex sync.Mutex
 		wg    sync.WaitGroup
+		// set when Fetch has returned, so late fetchers won't modify the returned data
+		finished bool
 	)
 
 	processFetcher := func(fetcher fetchersPkg.Fetcher) {
@@ -58,17 +270,60 @@ func (c *Controller) Fetch(ctx context.Context) (
 
 		c.Logger.Trace().Str("name", string(fetcher.Name())).Msg("Processing fetcher...")
 
+		mutex.Lock()
+		dependenciesRefreshed := fetchersStatus.IsAnyTrue(fetcher.Dependencies())
+		mutex.Unlock()
+
+		if cachedData, ok := c.GetCachedData(fetcher, dependenciesRefreshed); ok {
+			mutex.Lock()
+			if !finished {
+				c.StoreData(data, fetcher, cachedData)
+				fetchersStatus[fetcher.Name()] = false
+			}
+			mutex.Unlock()
+
+			c.Logger.Trace().
+				Str("name", string(fetcher.Name())).
+				Msg("Fetcher data is not yet stale, using cached data")
+
+			return
+		}
+
 		mutex.Lock()
 		fetcherDependenciesData := data.GetData(fetcher.Dependencies())
 		mutex.Unlock()
 
-		fetcherData, fetcherQueries := fetcher.Fetch(ctx, fetcherDependenciesData...)
+		fetcherCtx, span := c.Tracer.Start(
+			ctx,
+			"Fetcher "+string(fetcher.Name()),
+			trace.WithAttributes(attribute.String("fetcher", string(fetcher.Name()))),
+		)
+
+		fetchStart := time.Now()
+		fetcherData, fetcherQueries := fetcher.Fetch(fetcherCtx, fetcherDependenciesData...)
+		fetchDuration := time.Since(fetchStart)
+
+		setFetcherSpanAttributes(span, fetcherQueries)
+		span.End()
+
+		fetcherData = c.StoreFetchedData(fetcher.Name(), fetcherData, fetcherQueries)
+
+		execution := FetcherExecution{
+			Name:       fetcher.Name(),
+			Duration:   fetchDuration,
+			QueryInfos: fetcherQueries,
+		}
 
 		mutex.Lock()
-		data.Set(fetcher.Name(), fetcherData)
+		if finished {
+			c.addLateExecution(execution)
+		} else {
+			c.StoreData(data, fetcher, fetcherData)
 
-		queries = append(queries, fetcherQueries...)
-		fetchersStatus[fetcher.Name()] = true
+			queries = append(queries, fetcherQueries...)
+			fetchersStatus[fetcher.Name()] = true
+			executions = append(executions, execution)
+		}
 		mutex.Unlock()
 
 		c.Logger.Trace().
@@ -76,16 +331,23 @@ func (c *Controller) Fetch(ctx context.Context) (
 			Msg("Processed fetcher")
 	}
 
-	for {
+	timedOut := false
+
+	for !timedOut {
 		c.Logger.Trace().Msg("Processing all pending fetchers...")
 
-		if fetchersStatus.IsAllDone(c.Fetchers.GetNames()) {
+		mutex.Lock()
+		allDone := fetchersStatus.IsAllDone(c.Fetchers.GetNames())
+		mutex.Unlock()
+
+		if allDone {
 			c.Logger.Trace().Msg("All fetchers are fetched.")
 			break
 		}
 
 		fetchersToStart := fetchersPkg.Fetchers{}
 
+		mutex.Lock()
 		for _, fetcher := range c.Fetchers {
 			if _, ok := fetchersStatus[fetcher.Name()]; ok {
 				c.Logger.Trace().
@@ -105,6 +367,7 @@ func (c *Controller) Fetch(ctx context.Context) (
 
 			fetchersToStart = append(fetchersToStart, fetcher)
 		}
+		mutex.Unlock()
 
 		c.Logger.Trace().
 			Strs("names", fetchersToStart.GetNamesAsString()).
@@ -116,8 +379,104 @@ func (c *Controller) Fetch(ctx context.Context) (
 			go processFetcher(fetcher)
 		}
 
-		wg.Wait()
+		done := make(chan struct{})
+
+		go func() {
+			wg.Wait()
+			close(done)
+		}()
+
+		select {
+		case <-done:
+		case <-ctx.Done():
+			timedOut = true
+		}
 	}
 
-	return data, queries
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	finished = true
+
+	timedOutFetchers := []constants.FetcherName{}
+
+	if timedOut {
+		for _, fetcher := range c.Fetchers {
+			if _, ok := fetchersStatus[fetcher.Name()]; ok {
+				continue
+			}
+
+			timedOutFetchers = append(timedOutFetchers, fetcher.Name())
+
+			if lastData, ok := c.GetLastKnownData(fetcher.Name()); ok {
+				c.StoreData(data, fetcher, lastData)
+			}
+		}
+
+		c.Logger.Warn().
+			Err(ctx.Err()).
+			Strs("names", utils.Map(timedOutFetchers, func(name constants.FetcherName) string {
+				return string(name)
+			})).
+			Msg("Fetch timed out, some fetchers were not completed")
+	}
+
+	return &FetchResult{
+		State:            data,
+		QueryInfos:       queries,
+		TimedOutFetchers: timedOutFetchers,
+		Executions:       append(c.takeLateExecutions(), executions...),
+	}
+}
+
+func (c *Controller) addLateExecution(execution FetcherExecution) {
+	c.lateExecutionsMutex.Lock()
+	defer c.lateExecutionsMutex.Unlock()
+
+	c.lateExecutions = append(c.lateExecutions, execution)
+}
+
+func (c *Controller) takeLateExecutions() []FetcherExecution {
+	c.lateExecutionsMutex.Lock()
+	defer c.lateExecutionsMutex.Unlock()
+
+	executions := c.lateExecutions
+	c.lateExecutions = nil
+
+	return executions
+}
+
+func setFetcherSpanAttributes(span trace.Span, queryInfos []*types.QueryInfo) {
+	chains := []string{}
+	addresses := []string{}
+	failedQueries := 0
+
+	for _, queryInfo := range queryInfos {
+		if queryInfo == nil {
+			continue
+		}
+
+		if !slices.Contains(chains, queryInfo.Chain) {
+			chains = append(chains, queryInfo.Chain)
+		}
+
+		if queryInfo.Address != "" && !slices.Contains(addresses, queryInfo.Address) {
+			addresses = append(addresses, queryInfo.Address)
+		}
+
+		if !queryInfo.Success {
+			failedQueries++
+		}
+	}
+
+	span.SetAttributes(
+		attribute.StringSlice("chains", chains),
+		attribute.StringSlice("addresses", addresses),
+		attribute.Int("queries", len(queryInfos)),
+		attribute.Int("failed-queries", failedQueries),
+	)
+
+	if failedQueries > 0 {
+		span.SetStatus(codes.Error, "some queries failed")
+	}
 }
@@ -2,23 +2,475 @@ package controller
 
 import (
 	"context"
+	"main/pkg/constants"
 	fetchersPkg "main/pkg/fetchers"
 	loggerPkg "main/pkg/logger"
+	statePkg "main/pkg/state"
+	"main/pkg/tracing"
+	"main/pkg/types"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 )
 
+type CountingFetcher struct {
+	name         constants.FetcherName
+	dependencies []constants.FetcherName
+	calls        atomic.Int64
+}
+
+func (f *CountingFetcher) Name() constants.FetcherName {
+	return f.name
+}
+
+func (f *CountingFetcher) Dependencies() []constants.FetcherName {
+	return f.dependencies
+}
+
+func (f *CountingFetcher) Fetch(
+	ctx context.Context,
+	data ...any,
+) (int64, []*types.QueryInfo) {
+	return f.calls.Add(1), []*types.QueryInfo{{Chain: "chain", Address: "validator", Success: true}}
+}
+
+type FetcherResult[T any] struct {
+	Data       T
+	QueryInfos []*types.QueryInfo
+}
+
+type SequenceFetcher[T any] struct {
+	results []FetcherResult[T]
+	calls   atomic.Int64
+}
+
+func (f *SequenceFetcher[T]) Name() constants.FetcherName {
+	return constants.FetcherNameStub1
+}
+
+func (f *SequenceFetcher[T]) Dependencies() []constants.FetcherName {
+	return []constants.FetcherName{}
+}
+
+func (f *SequenceFetcher[T]) Fetch(
+	ctx context.Context,
+	data ...any,
+) (T, []*types.QueryInfo) {
+	result := f.results[f.calls.Add(1)-1]
+	return result.Data, result.QueryInfos
+}
+
+type BlockingFetcher struct {
+	unblock chan struct{}
+	calls   atomic.Int64
+}
+
+func (f *BlockingFetcher) Name() constants.FetcherName {
+	return constants.FetcherNameStub1
+}
+
+func (f *BlockingFetcher) Dependencies() []constants.FetcherName {
+	return []constants.FetcherName{}
+}
+
+func (f *BlockingFetcher) Fetch(
+	ctx context.Context,
+	data ...any,
+) (int64, []*types.QueryInfo) {
+	if f.calls.Add(1) > 1 {
+		<-f.unblock
+	}
+
+	return int64(1), []*types.QueryInfo{}
+}
+
 func TestControllerFetcherEnabled(t *testing.T) {
 	t.Parallel()
 
 	logger := loggerPkg.GetNopLogger()
-	controller := NewController(fetchersPkg.Fetchers{
-		&fetchersPkg.StubFetcher1{},
-		&fetchersPkg.StubFetcher2{},
-	}, logger)
+	controller, err := NewController(fetchersPkg.Fetchers{
+		fetchersPkg.Typed(&fetchersPkg.StubFetcher1{}),
+		fetchersPkg.Typed(&fetchersPkg.StubFetcher2{}),
+	}, []constants.FetcherName{constants.FetcherNameStub2}, nil, logger, tracing.InitNoopTracer())
+	require.NoError(t, err)
+
+	fetchResult := controller.Fetch(context.Background())
+	assert.Empty(t, fetchResult.QueryInfos)
+	assert.Empty(t, fetchResult.TimedOutFetchers)
+	assert.Equal(t, 2, fetchResult.State.Length())
+}
+
+func TestControllerRefreshIntervals(t *testing.T) {
+	t.Parallel()
+
+	slowFetcher := &CountingFetcher{name: constants.FetcherNameStub1}
+	fastFetcher := &CountingFetcher{name: constants.FetcherNameStub2}
+
+	logger := loggerPkg.GetNopLogger()
+	controller, err := NewController(
+		fetchersPkg.Fetchers{fetchersPkg.Typed(slowFetcher), fetchersPkg.Typed(fastFetcher)},
+		[]constants.FetcherName{constants.FetcherNameStub1, constants.FetcherNameStub2},
+		map[constants.FetcherName]time.Duration{
+			constants.FetcherNameStub1: time.Hour,
+			"nonexistent":              time.Hour,
+		},
+		logger,
+		tracing.InitNoopTracer(),
+	)
+	require.NoError(t, err)
+
+	fetchResult := controller.Fetch(context.Background())
+	assert.Len(t, fetchResult.QueryInfos, 2)
+
+	fetchResult = controller.Fetch(context.Background())
+	assert.Len(t, fetchResult.QueryInfos, 1)
+	assert.Equal(t, 2, fetchResult.State.Length())
+	assert.Equal(t, int64(1), slowFetcher.calls.Load())
+	assert.Equal(t, int64(2), fastFetcher.calls.Load())
+
+	slowData, ok := fetchResult.State.Get(constants.FetcherNameStub1)
+	assert.True(t, ok)
+	assert.Equal(t, int64(1), slowData)
+}
+
+func TestControllerRefreshIntervalsDependencyRefreshed(t *testing.T) {
+	t.Parallel()
+
+	dependency := &CountingFetcher{name: constants.FetcherNameStub1}
+	dependent := &CountingFetcher{
+		name:         constants.FetcherNameStub2,
+		dependencies: []constants.FetcherName{constants.FetcherNameStub1},
+	}
+
+	logger := loggerPkg.GetNopLogger()
+	controller, err := NewController(
+		fetchersPkg.Fetchers{fetchersPkg.Typed(dependency), fetchersPkg.Typed(dependent)},
+		[]constants.FetcherName{constants.FetcherNameStub2},
+		map[constants.FetcherName]time.Duration{
+			constants.FetcherNameStub2: time.Hour,
+		},
+		logger,
+		tracing.InitNoopTracer(),
+	)
+	require.NoError(t, err)
+
+	controller.Fetch(context.Background())
+	fetchResult := controller.Fetch(context.Background())
+	assert.Len(t, fetchResult.QueryInfos, 2)
+	assert.Equal(t, int64(2), dependent.calls.Load())
+}
+
+func TestControllerRefreshIntervalsExpired(t *testing.T) {
+	t.Parallel()
+
+	fetcher := &CountingFetcher{name: constants.FetcherNameStub1}
+
+	logger := loggerPkg.GetNopLogger()
+	controller, err := NewController(
+		fetchersPkg.Fetchers{fetchersPkg.Typed(fetcher)},
+		[]constants.FetcherName{constants.FetcherNameStub1},
+		map[constants.FetcherName]time.Duration{
+			constants.FetcherNameStub1: time.Hour,
+		},
+		logger,
+		tracing.InitNoopTracer(),
+	)
+	require.NoError(t, err)
+
+	controller.Fetch(context.Background())
+	controller.cache[constants.FetcherNameStub1] = CachedFetcherData{
+		Data:      int64(1),
+		UpdatedAt: time.Now().Add(-2 * time.Hour),
+	}
+
+	fetchResult := controller.Fetch(context.Background())
+	assert.Len(t, fetchResult.QueryInfos, 1)
+	assert.Equal(t, int64(2), fetcher.calls.Load())
+}
+
+func TestControllerServesLastKnownGoodData(t *testing.T) {
+	t.Parallel()
+
+	fetcher := &SequenceFetcher[fetchersPkg.DelegationsData]{results: []FetcherResult[fetchersPkg.DelegationsData]{
+		{
+			Data: fetchersPkg.DelegationsData{Delegations: map[string]map[string]uint64{
+				"chain1": {"validator": 1},
+				"chain2": {"validator": 2},
+			}},
+			QueryInfos: []*types.QueryInfo{
+				{Chain: "chain1", Success: true},
+				{Chain: "chain2", Success: true},
+			},
+		},
+		{
+			Data: fetchersPkg.DelegationsData{Delegations: map[string]map[string]uint64{
+				"chain1": {"validator": 3},
+				"chain2": {},
+			}},
+			QueryInfos: []*types.QueryInfo{
+				{Chain: "chain1", Success: true},
+				{Chain: "chain2", Success: false},
+			},
+		},
+	}}
+
+	logger := loggerPkg.GetNopLogger()
+	fetchers := fetchersPkg.Fetchers{fetchersPkg.Typed(fetcher)}
+	controller, err := NewController(fetchers, fetchers.GetNames(), nil, logger, tracing.InitNoopTracer())
+	require.NoError(t, err)
+
+	controller.Fetch(context.Background())
+	firstSuccessTimes := controller.GetLastSuccessTimes()
+
+	fetchResult := controller.Fetch(context.Background())
+	delegations, ok := statePkg.Get(fetchResult.State, stubKey)
+	assert.True(t, ok)
+	assert.Equal(t, uint64(3), delegations.Delegations["chain1"]["validator"])
+	assert.Equal(t, uint64(2), delegations.Delegations["chain2"]["validator"])
+
+	lastSuccessTimes := controller.GetLastSuccessTimes()
+	assert.Equal(
+		t,
+		firstSuccessTimes[constants.FetcherNameStub1]["chain2"],
+		lastSuccessTimes[constants.FetcherNameStub1]["chain2"],
+	)
+	assert.False(t, lastSuccessTimes[constants.FetcherNameStub1]["chain1"].Before(
+		firstSuccessTimes[constants.FetcherNameStub1]["chain1"],
+	))
+}
+
+func TestControllerDropsDataThatIsGone(t *testing.T) {
+	t.Parallel()
+
+	fetcher := &SequenceFetcher[fetchersPkg.DelegationsData]{results: []FetcherResult[fetchersPkg.DelegationsData]{
+		{
+			Data: fetchersPkg.DelegationsData{Delegations: map[string]map[string]uint64{
+				"chain1": {"validator1": 1, "validator2": 2},
+				"chain2": {"validator": 3},
+			}},
+			QueryInfos: []*types.QueryInfo{
+				{Chain: "chain1", Success: true},
+				{Chain: "chain2", Success: true},
+			},
+		},
+		{
+			// validator2 and chain2 are removed
+			Data: fetchersPkg.DelegationsData{Delegations: map[string]map[string]uint64{
+				"chain1": {"validator1": 4},
+			}},
+			QueryInfos: []*types.QueryInfo{{Chain: "chain1", Success: true}},
+		},
+	}}
+
+	logger := loggerPkg.GetNopLogger()
+	fetchers := fetchersPkg.Fetchers{fetchersPkg.Typed(fetcher)}
+	controller, err := NewController(fetchers, fetchers.GetNames(), nil, logger, tracing.InitNoopTracer())
+	require.NoError(t, err)
+
+	controller.Fetch(context.Background())
+
+	fetchResult := controller.Fetch(context.Background())
+	delegations, ok := statePkg.Get(fetchResult.State, stubKey)
+	assert.True(t, ok)
+	assert.Equal(t, map[string]map[string]uint64{"chain1": {"validator1": 4}}, delegations.Delegations)
+}
+
+func TestControllerRefreshesFailedFetcher(t *testing.T) {
+	t.Parallel()
+
+	fetcher := &SequenceFetcher[int64]{results: []FetcherResult[int64]{
+		{Data: int64(1), QueryInfos: []*types.QueryInfo{{Chain: "chain", Success: false}}},
+		{Data: int64(2), QueryInfos: []*types.QueryInfo{{Chain: "chain", Success: true}}},
+	}}
+
+	logger := loggerPkg.GetNopLogger()
+	controller, err := NewController(
+		fetchersPkg.Fetchers{fetchersPkg.Typed(fetcher)},
+		[]constants.FetcherName{constants.FetcherNameStub1},
+		map[constants.FetcherName]time.Duration{
+			constants.FetcherNameStub1: time.Hour,
+		},
+		logger,
+		tracing.InitNoopTracer(),
+	)
+	require.NoError(t, err)
+
+	controller.Fetch(context.Background())
+	fetchResult := controller.Fetch(context.Background())
+	assert.Len(t, fetchResult.QueryInfos, 1)
+	assert.Equal(t, int64(2), fetcher.calls.Load())
+
+	value, ok := fetchResult.State.Get(constants.FetcherNameStub1)
+	assert.True(t, ok)
+	assert.Equal(t, int64(2), value)
+	assert.Contains(t, controller.GetLastSuccessTimes()[constants.FetcherNameStub1], "chain")
+}
+
+func TestControllerFetchTimeout(t *testing.T) {
+	t.Parallel()
+
+	blockingFetcher := &BlockingFetcher{unblock: make(chan struct{})}
+	defer close(blockingFetcher.unblock)
+
+	logger := loggerPkg.GetNopLogger()
+	controller, err := NewController(
+		fetchersPkg.Fetchers{fetchersPkg.Typed(blockingFetcher), fetchersPkg.Typed(&fetchersPkg.StubFetcher2{})},
+		[]constants.FetcherName{constants.FetcherNameStub2},
+		nil,
+		logger,
+		tracing.InitNoopTracer(),
+	)
+	require.NoError(t, err)
+
+	// first one is not blocking, so there's data to fall back to
+	controller.Fetch(context.Background())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	fetchResult := controller.Fetch(ctx)
+	assert.ElementsMatch(t, []constants.FetcherName{
+		constants.FetcherNameStub1,
+		constants.FetcherNameStub2,
+	}, fetchResult.TimedOutFetchers)
+
+	value, ok := fetchResult.State.Get(constants.FetcherNameStub1)
+	assert.True(t, ok)
+	assert.Equal(t, int64(1), value)
+}
+
+func TestControllerInvalidDependencies(t *testing.T) {
+	t.Parallel()
+
+	logger := loggerPkg.GetNopLogger()
+	controller, err := NewController(fetchersPkg.Fetchers{
+		fetchersPkg.Typed(&CountingFetcher{
+			name:         constants.FetcherNameStub1,
+			dependencies: []constants.FetcherName{constants.FetcherNameStub2},
+		}),
+	}, []constants.FetcherName{constants.FetcherNameStub1}, nil, logger, tracing.InitNoopTracer())
+	require.Error(t, err)
+	assert.Nil(t, controller)
+}
+
+func TestControllerSkipsUnusedFetchers(t *testing.T) {
+	t.Parallel()
+
+	usedFetcher := &CountingFetcher{name: constants.FetcherNameStub1}
+	unusedFetcher := &CountingFetcher{name: constants.FetcherNameStub2}
+
+	logger := loggerPkg.GetNopLogger()
+	controller, err := NewController(
+		fetchersPkg.Fetchers{fetchersPkg.Typed(usedFetcher), fetchersPkg.Typed(unusedFetcher)},
+		[]constants.FetcherName{constants.FetcherNameStub1},
+		nil,
+		logger,
+		tracing.InitNoopTracer(),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, []constants.FetcherName{constants.FetcherNameStub1}, controller.Fetchers.GetNames())
+
+	fetchResult := controller.Fetch(context.Background())
+	assert.Equal(t, 1, fetchResult.State.Length())
+	assert.Equal(t, int64(1), usedFetcher.calls.Load())
+	assert.Equal(t, int64(0), unusedFetcher.calls.Load())
+}
+
+func TestControllerRequiredFetcherNotProvided(t *testing.T) {
+	t.Parallel()
+
+	logger := loggerPkg.GetNopLogger()
+	controller, err := NewController(
+		fetchersPkg.Fetchers{fetchersPkg.Typed(&CountingFetcher{name: constants.FetcherNameStub1})},
+		[]constants.FetcherName{constants.FetcherNameStub2},
+		nil,
+		logger,
+		tracing.InitNoopTracer(),
+	)
+	require.Error(t, err)
+	require.ErrorContains(t, err, "fetcher stub2 is required, but is not provided")
+	assert.Nil(t, controller)
+}
+
+func TestControllerFetcherExecutions(t *testing.T) {
+	t.Parallel()
+
+	spanRecorder := tracetest.NewSpanRecorder()
+	tracerProvider := tracesdk.NewTracerProvider(tracesdk.WithSpanProcessor(spanRecorder))
+
+	logger := loggerPkg.GetNopLogger()
+	controller, err := NewController(
+		fetchersPkg.Fetchers{fetchersPkg.Typed(&CountingFetcher{name: constants.FetcherNameStub1})},
+		[]constants.FetcherName{constants.FetcherNameStub1},
+		map[constants.FetcherName]time.Duration{
+			constants.FetcherNameStub1: time.Hour,
+		},
+		logger,
+		tracerProvider.Tracer("test"),
+	)
+	require.NoError(t, err)
+
+	fetchResult := controller.Fetch(context.Background())
+	require.Len(t, fetchResult.Executions, 1)
+	assert.Equal(t, constants.FetcherNameStub1, fetchResult.Executions[0].Name)
+	assert.Len(t, fetchResult.Executions[0].QueryInfos, 1)
+
+	spans := spanRecorder.Ended()
+	require.Len(t, spans, 1)
+	assert.Equal(t, "Fetcher stub1", spans[0].Name())
+	assert.Contains(t, spans[0].Attributes(), attribute.StringSlice("chains", []string{"chain"}))
+	assert.Contains(t, spans[0].Attributes(), attribute.StringSlice("addresses", []string{"validator"}))
+	assert.Contains(t, spans[0].Attributes(), attribute.Int("queries", 1))
+
+	// cached data is not a fetcher execution
+	fetchResult = controller.Fetch(context.Background())
+	assert.Empty(t, fetchResult.Executions)
+	assert.Len(t, spanRecorder.Ended(), 1)
+}
+
+func TestControllerReportsLateExecutions(t *testing.T) {
+	t.Parallel()
+
+	blockingFetcher := &BlockingFetcher{unblock: make(chan struct{})}
+
+	logger := loggerPkg.GetNopLogger()
+	controller, err := NewController(
+		fetchersPkg.Fetchers{fetchersPkg.Typed(blockingFetcher)},
+		[]constants.FetcherName{constants.FetcherNameStub1},
+		nil,
+		logger,
+		tracing.InitNoopTracer(),
+	)
+	require.NoError(t, err)
+
+	controller.Fetch(context.Background())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	fetchResult := controller.Fetch(ctx)
+	assert.Empty(t, fetchResult.Executions)
+
+	close(blockingFetcher.unblock)
+
+	require.Eventually(t, func() bool {
+		controller.lateExecutionsMutex.Lock()
+		defer controller.lateExecutionsMutex.Unlock()
+
+		return len(controller.lateExecutions) == 1
+	}, time.Second, 10*time.Millisecond)
+
+	fetchResult = controller.Fetch(context.Background())
+	require.Len(t, fetchResult.Executions, 2)
+	assert.Equal(t, constants.FetcherNameStub1, fetchResult.Executions[0].Name)
+	assert.GreaterOrEqual(t, fetchResult.Executions[0].Duration, 50*time.Millisecond)
 
-	data, queryInfos := controller.Fetch(context.Background())
-	assert.Empty(t, queryInfos)
-	assert.Equal(t, 2, data.Length())
+	fetchResult = controller.Fetch(context.Background())
+	assert.Len(t, fetchResult.Executions, 1)
 }
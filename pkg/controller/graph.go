@@ -0,0 +1,206 @@
+package controller
+
+import (
+	"fmt"
+	"main/pkg/constants"
+	fetchersPkg "main/pkg/fetchers"
+	"slices"
+	"strings"
+)
+
+type ExecutionPlan struct {
+	Stages       [][]constants.FetcherName
+	Dependencies map[constants.FetcherName][]constants.FetcherName
+}
+
+func NewExecutionPlan(fetchers fetchersPkg.Fetchers) (*ExecutionPlan, error) {
+	dependencies := make(map[constants.FetcherName][]constants.FetcherName, len(fetchers))
+	names := fetchers.GetNames()
+
+	for _, fetcher := range fetchers {
+		if _, ok := dependencies[fetcher.Name()]; ok {
+			return nil, fmt.Errorf("fetcher %s is declared more than once", fetcher.Name())
+		}
+
+		dependencies[fetcher.Name()] = fetcher.Dependencies()
+	}
+
+	for _, fetcher := range fetchers {
+		for _, dependency := range fetcher.Dependencies() {
+			if !slices.Contains(names, dependency) {
+				return nil, fmt.Errorf(
+					"fetcher %s depends on %s, which is not provided by any fetcher",
+					fetcher.Name(),
+					dependency,
+				)
+			}
+		}
+	}
+
+	stages := [][]constants.FetcherName{}
+	processed := make(map[constants.FetcherName]bool, len(fetchers))
+
+	for len(processed) < len(names) {
+		stage := []constants.FetcherName{}
+
+		for _, name := range names {
+			if processed[name] {
+				continue
+			}
+
+			allDependenciesProcessed := true
+
+			for _, dependency := range dependencies[name] {
+				if !processed[dependency] {
+					allDependenciesProcessed = false
+					break
+				}
+			}
+
+			if allDependenciesProcessed {
+				stage = append(stage, name)
+			}
+		}
+
+		if len(stage) == 0 {
+			return nil, fmt.Errorf(
+				"dependency cycle detected: %s",
+				joinNames(findCycle(names, dependencies, processed), " -> "),
+			)
+		}
+
+		for _, name := range stage {
+			processed[name] = true
+		}
+
+		stages = append(stages, stage)
+	}
+
+	return &ExecutionPlan{
+		Stages:       stages,
+		Dependencies: dependencies,
+	}, nil
+}
+
+func (p *ExecutionPlan) WithDependencies(names []constants.FetcherName) []constants.FetcherName {
+	required := make(map[constants.FetcherName]bool, len(names))
+	queue := slices.Clone(names)
+
+	for len(queue) > 0 {
+		name := queue[0]
+		queue = queue[1:]
+
+		if required[name] {
+			continue
+		}
+
+		required[name] = true
+		queue = append(queue, p.Dependencies[name]...)
+	}
+
+	result := []constants.FetcherName{}
+
+	for _, stage := range p.Stages {
+		for _, name := range stage {
+			if required[name] {
+				result = append(result, name)
+			}
+		}
+	}
+
+	return result
+}
+
+func findCycle(
+	names []constants.FetcherName,
+	dependencies map[constants.FetcherName][]constants.FetcherName,
+	processed map[constants.FetcherName]bool,
+) []constants.FetcherName {
+	var current constants.FetcherName
+
+	for _, name := range names {
+		if !processed[name] {
+			current = name
+			break
+		}
+	}
+
+	path := []constants.FetcherName{}
+	visitedAt := map[constants.FetcherName]int{}
+
+	for {
+		if index, ok := visitedAt[current]; ok {
+			return append(path[index:], current)
+		}
+
+		visitedAt[current] = len(path)
+		path = append(path, current)
+
+		for _, dependency := range dependencies[current] {
+			if !processed[dependency] {
+				current = dependency
+				break
+			}
+		}
+	}
+}
+
+func (p *ExecutionPlan) String() string {
+	var sb strings.Builder
+
+	for index, stage := range p.Stages {
+		sb.WriteString(fmt.Sprintf("Stage %d:\n", index+1))
+
+		for _, name := range stage {
+			dependencies := p.Dependencies[name]
+			if len(dependencies) == 0 {
+				sb.WriteString(fmt.Sprintf("  - %s\n", name))
+				continue
+			}
+
+			sb.WriteString(fmt.Sprintf("  - %s (depends on: %s)\n", name, joinNames(dependencies, ", ")))
+		}
+	}
+
+	return sb.String()
+}
+
+func (p *ExecutionPlan) DOT() string {
+	var sb strings.Builder
+
+	sb.WriteString("digraph fetchers {\n")
+	sb.WriteString("  rankdir=LR;\n")
+
+	for index, stage := range p.Stages {
+		sb.WriteString(fmt.Sprintf("  subgraph cluster_stage_%d {\n", index+1))
+		sb.WriteString(fmt.Sprintf("    label=\"Stage %d\";\n", index+1))
+
+		for _, name := range stage {
+			sb.WriteString(fmt.Sprintf("    %q;\n", name))
+		}
+
+		sb.WriteString("  }\n")
+	}
+
+	for _, stage := range p.Stages {
+		for _, name := range stage {
+			for _, dependency := range p.Dependencies[name] {
+				sb.WriteString(fmt.Sprintf("  %q -> %q;\n", dependency, name))
+			}
+		}
+	}
+
+	sb.WriteString("}\n")
+
+	return sb.String()
+}
+
+func joinNames(names []constants.FetcherName, separator string) string {
+	namesAsStrings := make([]string, len(names))
+
+	for index, name := range names {
+		namesAsStrings[index] = string(name)
+	}
+
+	return strings.Join(namesAsStrings, separator)
+}
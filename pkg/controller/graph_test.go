@@ -0,0 +1,130 @@
+package controller
+
+import (
+	"main/pkg/constants"
+	fetchersPkg "main/pkg/fetchers"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExecutionPlanMissingDependency(t *testing.T) {
+	t.Parallel()
+
+	plan, err := NewExecutionPlan(fetchersPkg.Fetchers{
+		fetchersPkg.Typed(&CountingFetcher{name: "first", dependencies: []constants.FetcherName{"missing"}}),
+	})
+	require.Error(t, err)
+	require.ErrorContains(t, err, "fetcher first depends on missing, which is not provided by any fetcher")
+	assert.Nil(t, plan)
+}
+
+func TestExecutionPlanDuplicateFetcher(t *testing.T) {
+	t.Parallel()
+
+	plan, err := NewExecutionPlan(fetchersPkg.Fetchers{
+		fetchersPkg.Typed(&CountingFetcher{name: "first"}),
+		fetchersPkg.Typed(&CountingFetcher{name: "first"}),
+	})
+	require.Error(t, err)
+	require.ErrorContains(t, err, "fetcher first is declared more than once")
+	assert.Nil(t, plan)
+}
+
+func TestExecutionPlanCycle(t *testing.T) {
+	t.Parallel()
+
+	plan, err := NewExecutionPlan(fetchersPkg.Fetchers{
+		fetchersPkg.Typed(&CountingFetcher{name: "independent"}),
+		fetchersPkg.Typed(&CountingFetcher{name: "first", dependencies: []constants.FetcherName{"independent", "second"}}),
+		fetchersPkg.Typed(&CountingFetcher{name: "second", dependencies: []constants.FetcherName{"third"}}),
+		fetchersPkg.Typed(&CountingFetcher{name: "third", dependencies: []constants.FetcherName{"first"}}),
+	})
+	require.Error(t, err)
+	require.ErrorContains(t, err, "dependency cycle detected: first -> second -> third -> first")
+	assert.Nil(t, plan)
+}
+
+func TestExecutionPlanSelfDependency(t *testing.T) {
+	t.Parallel()
+
+	plan, err := NewExecutionPlan(fetchersPkg.Fetchers{
+		fetchersPkg.Typed(&CountingFetcher{name: "first", dependencies: []constants.FetcherName{"first"}}),
+	})
+	require.Error(t, err)
+	require.ErrorContains(t, err, "dependency cycle detected: first -> first")
+	assert.Nil(t, plan)
+}
+
+func TestExecutionPlanOk(t *testing.T) {
+	t.Parallel()
+
+	plan, err := NewExecutionPlan(fetchersPkg.Fetchers{
+		fetchersPkg.Typed(&CountingFetcher{name: "third", dependencies: []constants.FetcherName{"first", "second"}}),
+		fetchersPkg.Typed(&CountingFetcher{name: "second", dependencies: []constants.FetcherName{"first"}}),
+		fetchersPkg.Typed(&CountingFetcher{name: "first"}),
+		fetchersPkg.Typed(&CountingFetcher{name: "other"}),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, [][]constants.FetcherName{
+		{"first", "other"},
+		{"second"},
+		{"third"},
+	}, plan.Stages)
+
+	assert.Equal(
+		t,
+		"Stage 1:\n"+
+			"  - first\n"+
+			"  - other\n"+
+			"Stage 2:\n"+
+			"  - second (depends on: first)\n"+
+			"Stage 3:\n"+
+			"  - third (depends on: first, second)\n",
+		plan.String(),
+	)
+
+	dot := plan.DOT()
+	assert.Contains(t, dot, "digraph fetchers {")
+	assert.Contains(t, dot, "subgraph cluster_stage_1 {")
+	assert.Contains(t, dot, "subgraph cluster_stage_3 {")
+	assert.Contains(t, dot, "\"first\" -> \"third\";")
+	assert.Contains(t, dot, "\"second\" -> \"third\";")
+	assert.Contains(t, dot, "\"first\" -> \"second\";")
+}
+
+func TestExecutionPlanAllFetchers(t *testing.T) {
+	t.Parallel()
+
+	plan, err := NewExecutionPlan(fetchersPkg.Fetchers{
+		fetchersPkg.Typed(&fetchersPkg.StubFetcher1{}),
+		fetchersPkg.Typed(&fetchersPkg.StubFetcher2{}),
+	})
+	require.NoError(t, err)
+	assert.Len(t, plan.Stages, 2)
+}
+
+func TestExecutionPlanWithDependencies(t *testing.T) {
+	t.Parallel()
+
+	plan, err := NewExecutionPlan(fetchersPkg.Fetchers{
+		fetchersPkg.Typed(&CountingFetcher{name: "third", dependencies: []constants.FetcherName{"second"}}),
+		fetchersPkg.Typed(&CountingFetcher{name: "second", dependencies: []constants.FetcherName{"first"}}),
+		fetchersPkg.Typed(&CountingFetcher{name: "first"}),
+		fetchersPkg.Typed(&CountingFetcher{name: "other"}),
+	})
+	require.NoError(t, err)
+
+	assert.Equal(
+		t,
+		[]constants.FetcherName{"first", "second", "third"},
+		plan.WithDependencies([]constants.FetcherName{"third"}),
+	)
+	assert.Equal(
+		t,
+		[]constants.FetcherName{"other"},
+		plan.WithDependencies([]constants.FetcherName{"other"}),
+	)
+	assert.Empty(t, plan.WithDependencies([]constants.FetcherName{}))
+}
@@ -0,0 +1,110 @@
+package controller
+
+import (
+	"encoding/json"
+	"main/pkg/constants"
+	statePkg "main/pkg/state"
+	"slices"
+	"time"
+)
+
+type FetcherSnapshot struct {
+	Data        json.RawMessage      `json:"data"`
+	UpdatedAt   time.Time            `json:"updated_at"`
+	LastSuccess map[string]time.Time `json:"last_success"`
+}
+
+type Snapshot struct {
+	CreatedAt time.Time                                 `json:"created_at"`
+	Fetchers  map[constants.FetcherName]FetcherSnapshot `json:"fetchers"`
+}
+
+func (c *Controller) GetSnapshot() ([]byte, error) {
+	c.cacheMutex.Lock()
+	defer c.cacheMutex.Unlock()
+
+	snapshot := Snapshot{
+		CreatedAt: time.Now(),
+		Fetchers:  make(map[constants.FetcherName]FetcherSnapshot, len(c.cache)),
+	}
+
+	for fetcherName, cached := range c.cache {
+		if cached.Data == nil {
+			continue
+		}
+
+		data, err := json.Marshal(cached.Data)
+		if err != nil {
+			return nil, err
+		}
+
+		snapshot.Fetchers[fetcherName] = FetcherSnapshot{
+			Data:        data,
+			UpdatedAt:   cached.UpdatedAt,
+			LastSuccess: c.lastSuccess[fetcherName],
+		}
+	}
+
+	return json.Marshal(snapshot)
+}
+
+// LoadSnapshot skips unknown or undecodable fetchers, so an outdated snapshot never blocks startup.
+func (c *Controller) LoadSnapshot(content []byte) error {
+	var snapshot Snapshot
+	if err := json.Unmarshal(content, &snapshot); err != nil {
+		return err
+	}
+
+	fetcherNames := c.Fetchers.GetNames()
+
+	c.cacheMutex.Lock()
+	defer c.cacheMutex.Unlock()
+
+	for fetcherName, fetcherSnapshot := range snapshot.Fetchers {
+		if !slices.Contains(fetcherNames, fetcherName) {
+			c.Logger.Debug().
+				Str("name", string(fetcherName)).
+				Msg("Fetcher from snapshot is not enabled, skipping it.")
+			continue
+		}
+
+		data, err := statePkg.Decode(fetcherName, fetcherSnapshot.Data)
+		if err != nil {
+			c.Logger.Warn().
+				Err(err).
+				Str("name", string(fetcherName)).
+				Msg("Could not decode fetcher data from snapshot, skipping it.")
+			continue
+		}
+
+		c.cache[fetcherName] = CachedFetcherData{
+			Data:      data,
+			UpdatedAt: fetcherSnapshot.UpdatedAt,
+		}
+
+		lastSuccess := make(map[string]time.Time, len(fetcherSnapshot.LastSuccess))
+		for chain, updatedAt := range fetcherSnapshot.LastSuccess {
+			lastSuccess[chain] = updatedAt
+		}
+
+		c.lastSuccess[fetcherName] = lastSuccess
+	}
+
+	c.Logger.Info().
+		Time("created-at", snapshot.CreatedAt).
+		Msg("Loaded state from snapshot")
+
+	return nil
+}
+
+func (c *Controller) GetLastKnownState() *statePkg.State {
+	state := statePkg.NewState()
+
+	for _, fetcher := range c.Fetchers {
+		if data, ok := c.GetLastKnownData(fetcher.Name()); ok {
+			c.StoreData(state, fetcher, data)
+		}
+	}
+
+	return state
+}
@@ -0,0 +1,102 @@
+package controller
+
+import (
+	"context"
+	"main/pkg/constants"
+	fetchersPkg "main/pkg/fetchers"
+	loggerPkg "main/pkg/logger"
+	statePkg "main/pkg/state"
+	"main/pkg/tracing"
+	"main/pkg/types"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var stubKey = statePkg.NewKey[fetchersPkg.DelegationsData](constants.FetcherNameStub1)
+
+func TestControllerSnapshotRestore(t *testing.T) {
+	t.Parallel()
+
+	delegations := fetchersPkg.DelegationsData{Delegations: map[string]map[string]uint64{
+		"chain": {"validator": 1},
+	}}
+
+	fetcher := &SequenceFetcher[fetchersPkg.DelegationsData]{results: []FetcherResult[fetchersPkg.DelegationsData]{
+		{Data: delegations, QueryInfos: []*types.QueryInfo{{Chain: "chain", Success: true}}},
+	}}
+
+	logger := loggerPkg.GetNopLogger()
+	controller, err := NewController(
+		fetchersPkg.Fetchers{fetchersPkg.Typed(fetcher)},
+		[]constants.FetcherName{constants.FetcherNameStub1},
+		nil,
+		logger,
+		tracing.InitNoopTracer(),
+	)
+	require.NoError(t, err)
+
+	controller.Fetch(context.Background())
+
+	snapshot, err := controller.GetSnapshot()
+	require.NoError(t, err)
+
+	restoredController, err := NewController(
+		fetchersPkg.Fetchers{fetchersPkg.Typed(&SequenceFetcher[fetchersPkg.DelegationsData]{})},
+		[]constants.FetcherName{constants.FetcherNameStub1},
+		nil,
+		logger,
+		tracing.InitNoopTracer(),
+	)
+	require.NoError(t, err)
+
+	err = restoredController.LoadSnapshot(snapshot)
+	require.NoError(t, err)
+
+	restored, ok := statePkg.Get(restoredController.GetLastKnownState(), stubKey)
+	require.True(t, ok)
+	assert.Equal(t, delegations, restored)
+
+	lastSuccess := controller.GetLastSuccessTimes()[constants.FetcherNameStub1]["chain"]
+	restoredLastSuccess := restoredController.GetLastSuccessTimes()[constants.FetcherNameStub1]["chain"]
+	assert.True(t, lastSuccess.Equal(restoredLastSuccess))
+}
+
+func TestControllerSnapshotSkipsUnknownAndInvalid(t *testing.T) {
+	t.Parallel()
+
+	logger := loggerPkg.GetNopLogger()
+	controller, err := NewController(
+		fetchersPkg.Fetchers{fetchersPkg.Typed(&SequenceFetcher[fetchersPkg.DelegationsData]{})},
+		[]constants.FetcherName{constants.FetcherNameStub1},
+		nil,
+		logger,
+		tracing.InitNoopTracer(),
+	)
+	require.NoError(t, err)
+
+	err = controller.LoadSnapshot([]byte(`{"fetchers":{` +
+		`"stub1":{"data":{"Delegations":"invalid"}},` +
+		`"stub2":{"data":{"Delegations":{}}}` +
+		`}}`))
+	require.NoError(t, err)
+	assert.Zero(t, controller.GetLastKnownState().Length())
+}
+
+func TestControllerSnapshotInvalid(t *testing.T) {
+	t.Parallel()
+
+	logger := loggerPkg.GetNopLogger()
+	controller, err := NewController(
+		fetchersPkg.Fetchers{fetchersPkg.Typed(&SequenceFetcher[fetchersPkg.DelegationsData]{})},
+		[]constants.FetcherName{constants.FetcherNameStub1},
+		nil,
+		logger,
+		tracing.InitNoopTracer(),
+	)
+	require.NoError(t, err)
+
+	err = controller.LoadSnapshot([]byte("invalid"))
+	require.Error(t, err)
+}
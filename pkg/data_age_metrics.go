@@ -0,0 +1,41 @@
+package pkg
+
+import (
+	"main/pkg/constants"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type DataAgeMetrics struct {
+	LastSuccessTimes map[constants.FetcherName]map[string]time.Time
+}
+
+func NewDataAgeMetrics(lastSuccessTimes map[constants.FetcherName]map[string]time.Time) *DataAgeMetrics {
+	return &DataAgeMetrics{
+		LastSuccessTimes: lastSuccessTimes,
+	}
+}
+
+func (m *DataAgeMetrics) GetMetrics() []prometheus.Collector {
+	dataAgeGauge := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: constants.MetricsPrefix + "data_age_seconds",
+			Help: "Seconds since the data for this fetcher and chain was last fetched successfully",
+		},
+		[]string{"fetcher", "chain"},
+	)
+
+	now := time.Now()
+
+	for fetcherName, chains := range m.LastSuccessTimes {
+		for chain, lastSuccess := range chains {
+			dataAgeGauge.With(prometheus.Labels{
+				"fetcher": string(fetcherName),
+				"chain":   chain,
+			}).Set(now.Sub(lastSuccess).Seconds())
+		}
+	}
+
+	return []prometheus.Collector{dataAgeGauge}
+}
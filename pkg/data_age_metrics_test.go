@@ -0,0 +1,39 @@
+package pkg
+
+import (
+	"main/pkg/constants"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataAgeMetrics(t *testing.T) {
+	t.Parallel()
+
+	generator := NewDataAgeMetrics(map[constants.FetcherName]map[string]time.Time{
+		constants.FetcherNameValidators: {
+			"chain1": time.Now().Add(-time.Minute),
+			"chain2": time.Now(),
+		},
+		constants.FetcherNameSigningInfo: {
+			"chain1": time.Now().Add(-time.Hour),
+		},
+	})
+	metrics := generator.GetMetrics()
+	assert.Len(t, metrics, 1)
+
+	dataAgeGauge, ok := metrics[0].(*prometheus.GaugeVec)
+	assert.True(t, ok)
+	assert.Equal(t, 3, testutil.CollectAndCount(dataAgeGauge))
+	assert.InDelta(t, 60, testutil.ToFloat64(dataAgeGauge.With(prometheus.Labels{
+		"fetcher": "validators",
+		"chain":   "chain1",
+	})), 1)
+	assert.InDelta(t, 3600, testutil.ToFloat64(dataAgeGauge.With(prometheus.Labels{
+		"fetcher": "signing-info",
+		"chain":   "chain1",
+	})), 1)
+}
@@ -0,0 +1,186 @@
+package pkg
+
+import (
+	"main/pkg/constants"
+	"main/pkg/tendermint"
+	"main/pkg/utils"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type EndpointsMetrics struct {
+	RPCs map[string]*tendermint.RPCWithConsumers
+}
+
+func NewEndpointsMetrics(rpcs map[string]*tendermint.RPCWithConsumers) *EndpointsMetrics {
+	return &EndpointsMetrics{
+		RPCs: rpcs,
+	}
+}
+
+func (m *EndpointsMetrics) GetMetrics() []prometheus.Collector {
+	healthyGauge := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: constants.MetricsPrefix + "lcd_endpoint_healthy",
+			Help: "Whether the LCD endpoint is considered healthy and is preferred for queries (1 if yes, 0 if no)",
+		},
+		[]string{"chain", "endpoint"},
+	)
+
+	latencyGauge := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: constants.MetricsPrefix + "lcd_endpoint_latency_seconds",
+			Help: "Moving average of the LCD endpoint successful queries latency",
+		},
+		[]string{"chain", "endpoint"},
+	)
+
+	errorRateGauge := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: constants.MetricsPrefix + "lcd_endpoint_error_rate",
+			Help: "Moving average of the LCD endpoint failed queries ratio, from 0 to 1",
+		},
+		[]string{"chain", "endpoint"},
+	)
+
+	heightGauge := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: constants.MetricsPrefix + "lcd_endpoint_height",
+			Help: "The last block height the LCD endpoint returned data for",
+		},
+		[]string{"chain", "endpoint"},
+	)
+
+	queriesCounter := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: constants.MetricsPrefix + "lcd_endpoint_queries_total",
+			Help: "Total queries done to the LCD endpoint",
+		},
+		[]string{"chain", "endpoint"},
+	)
+
+	errorsCounter := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: constants.MetricsPrefix + "lcd_endpoint_errors_total",
+			Help: "Total failed queries done to the LCD endpoint",
+		},
+		[]string{"chain", "endpoint"},
+	)
+
+	heightRegressionsCounter := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: constants.MetricsPrefix + "lcd_endpoint_height_regressions_total",
+			Help: "Total responses from the LCD endpoint rejected as they were for a height lower than the one returned previously",
+		},
+		[]string{"chain", "endpoint"},
+	)
+
+	lagGauge := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: constants.MetricsPrefix + "node_lag_seconds",
+			Help: "How long ago the latest block of the node behind the LCD endpoint was produced, as of the last check",
+		},
+		[]string{"chain", "endpoint"},
+	)
+
+	syncingGauge := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: constants.MetricsPrefix + "node_syncing",
+			Help: "Whether the node behind the LCD endpoint is catching up, as of the last check (1 if yes, 0 if no)",
+		},
+		[]string{"chain", "endpoint"},
+	)
+
+	endpointCircuitStateGauge := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: constants.MetricsPrefix + "lcd_endpoint_circuit_breaker_state",
+			Help: "State of the LCD endpoint circuit breaker (0 if closed, 1 if half-open, 2 if open and the endpoint is skipped)",
+		},
+		[]string{"chain", "endpoint"},
+	)
+
+	chainCircuitStateGauge := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: constants.MetricsPrefix + "chain_circuit_breaker_state",
+			Help: "State of the chain circuit breaker (0 if closed, 1 if half-open, 2 if open and the chain is skipped)",
+		},
+		[]string{"chain"},
+	)
+
+	rpcCircuitStateGauge := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: constants.MetricsPrefix + "rpc_endpoint_circuit_breaker_state",
+			Help: "State of the CometBFT RPC endpoint circuit breaker (0 if closed, 1 if half-open, 2 if open and the endpoint is skipped)",
+		},
+		[]string{"chain", "endpoint"},
+	)
+
+	grpcCircuitStateGauge := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: constants.MetricsPrefix + "grpc_endpoint_circuit_breaker_state",
+			Help: "State of the gRPC endpoint circuit breaker (0 if closed, 1 if half-open, 2 if open and LCD is queried instead)",
+		},
+		[]string{"chain", "endpoint"},
+	)
+
+	for _, rpcWithConsumers := range m.RPCs {
+		rpcs := append([]*tendermint.RPC{rpcWithConsumers.RPC}, rpcWithConsumers.Consumers...)
+
+		for _, rpc := range rpcs {
+			chainCircuitStateGauge.With(prometheus.Labels{
+				"chain": rpc.ChainName,
+			}).Set(float64(rpc.Breaker.State()))
+
+			if rpc.Comet != nil {
+				rpcCircuitStateGauge.With(prometheus.Labels{
+					"chain":    rpc.ChainName,
+					"endpoint": utils.RedactURL(rpc.Comet.Host),
+				}).Set(float64(rpc.Comet.Breaker.State()))
+			}
+
+			if rpc.GRPC != nil {
+				grpcCircuitStateGauge.With(prometheus.Labels{
+					"chain":    rpc.ChainName,
+					"endpoint": utils.RedactURL(rpc.GRPC.Address()),
+				}).Set(float64(rpc.GRPCBreaker.State()))
+			}
+
+			for _, stats := range rpc.Endpoints.Stats() {
+				labels := prometheus.Labels{
+					"chain":    rpc.ChainName,
+					"endpoint": stats.Host,
+				}
+
+				healthyGauge.With(labels).Set(utils.BoolToFloat64(stats.Healthy))
+				latencyGauge.With(labels).Set(stats.Latency.Seconds())
+				errorRateGauge.With(labels).Set(stats.ErrorRate)
+				heightGauge.With(labels).Set(float64(stats.Height))
+				queriesCounter.With(labels).Add(float64(stats.Queries))
+				errorsCounter.With(labels).Add(float64(stats.Errors))
+				heightRegressionsCounter.With(labels).Add(float64(stats.HeightRegressions))
+				endpointCircuitStateGauge.With(labels).Set(float64(stats.CircuitState))
+
+				if stats.StatusChecked {
+					lagGauge.With(labels).Set(stats.Lag.Seconds())
+					syncingGauge.With(labels).Set(utils.BoolToFloat64(stats.Syncing))
+				}
+			}
+		}
+	}
+
+	return []prometheus.Collector{
+		healthyGauge,
+		latencyGauge,
+		errorRateGauge,
+		heightGauge,
+		queriesCounter,
+		errorsCounter,
+		heightRegressionsCounter,
+		lagGauge,
+		syncingGauge,
+		endpointCircuitStateGauge,
+		chainCircuitStateGauge,
+		rpcCircuitStateGauge,
+		grpcCircuitStateGauge,
+	}
+}
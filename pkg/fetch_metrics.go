@@ -0,0 +1,46 @@
+package pkg
+
+import (
+	"main/pkg/constants"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type FetchMetrics struct {
+	FetcherNames     []constants.FetcherName
+	TimedOutFetchers []constants.FetcherName
+}
+
+func NewFetchMetrics(
+	fetcherNames []constants.FetcherName,
+	timedOutFetchers []constants.FetcherName,
+) *FetchMetrics {
+	return &FetchMetrics{
+		FetcherNames:     fetcherNames,
+		TimedOutFetchers: timedOutFetchers,
+	}
+}
+
+func (m *FetchMetrics) GetMetrics() []prometheus.Collector {
+	timedOutGauge := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: constants.MetricsPrefix + "fetcher_timed_out",
+			Help: "Whether the fetcher did not complete before the scrape timeout (1 if yes, 0 if no)",
+		},
+		[]string{"fetcher"},
+	)
+
+	for _, fetcherName := range m.FetcherNames {
+		timedOutGauge.With(prometheus.Labels{
+			"fetcher": string(fetcherName),
+		}).Set(0)
+	}
+
+	for _, fetcherName := range m.TimedOutFetchers {
+		timedOutGauge.With(prometheus.Labels{
+			"fetcher": string(fetcherName),
+		}).Set(1)
+	}
+
+	return []prometheus.Collector{timedOutGauge}
+}
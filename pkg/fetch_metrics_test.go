@@ -0,0 +1,31 @@
+package pkg
+
+import (
+	"main/pkg/constants"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFetchMetrics(t *testing.T) {
+	t.Parallel()
+
+	generator := NewFetchMetrics(
+		[]constants.FetcherName{constants.FetcherNameValidators, constants.FetcherNameSigningInfo},
+		[]constants.FetcherName{constants.FetcherNameSigningInfo},
+	)
+	metrics := generator.GetMetrics()
+	assert.Len(t, metrics, 1)
+
+	timedOutGauge, ok := metrics[0].(*prometheus.GaugeVec)
+	assert.True(t, ok)
+	assert.Equal(t, 2, testutil.CollectAndCount(timedOutGauge))
+	assert.Zero(t, testutil.ToFloat64(timedOutGauge.With(prometheus.Labels{
+		"fetcher": "validators",
+	})))
+	assert.InDelta(t, 1, testutil.ToFloat64(timedOutGauge.With(prometheus.Labels{
+		"fetcher": "signing-info",
+	})), 0.01)
+}
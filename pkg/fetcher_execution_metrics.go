@@ -0,0 +1,116 @@
+package pkg
+
+import (
+	"main/pkg/constants"
+	controllerPkg "main/pkg/controller"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type FetcherExecutionMetrics struct {
+	DurationHistogram      *prometheus.HistogramVec
+	QueryDurationHistogram *prometheus.HistogramVec
+	RunsCounter            *prometheus.CounterVec
+	QueriesCounter         *prometheus.CounterVec
+}
+
+func NewFetcherExecutionMetrics(fetcherNames []constants.FetcherName) *FetcherExecutionMetrics {
+	durationHistogram := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    constants.MetricsPrefix + "fetcher_duration_seconds",
+			Help:    "Time it took for the fetcher to fetch all of its data",
+			Buckets: prometheus.ExponentialBuckets(0.05, 2, 12),
+		},
+		[]string{"fetcher"},
+	)
+
+	queryDurationHistogram := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    constants.MetricsPrefix + "fetcher_query_duration_seconds",
+			Help:    "Time it took for a single query done by the fetcher",
+			Buckets: prometheus.ExponentialBuckets(0.05, 2, 12),
+		},
+		[]string{"fetcher", "chain"},
+	)
+
+	runsCounter := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: constants.MetricsPrefix + "fetcher_runs_total",
+			Help: "Total fetcher runs, by status (failure if any of the fetcher queries failed)",
+		},
+		[]string{"fetcher", "status"},
+	)
+
+	queriesCounter := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: constants.MetricsPrefix + "fetcher_queries_total",
+			Help: "Total queries done by the fetcher, by chain and status",
+		},
+		[]string{"fetcher", "chain", "status"},
+	)
+
+	// so we would have these metrics even if fetchers were not run yet
+	for _, fetcherName := range fetcherNames {
+		for _, status := range []string{"success", "failure"} {
+			runsCounter.With(prometheus.Labels{
+				"fetcher": string(fetcherName),
+				"status":  status,
+			}).Add(0)
+		}
+	}
+
+	return &FetcherExecutionMetrics{
+		DurationHistogram:      durationHistogram,
+		QueryDurationHistogram: queryDurationHistogram,
+		RunsCounter:            runsCounter,
+		QueriesCounter:         queriesCounter,
+	}
+}
+
+func (m *FetcherExecutionMetrics) Record(executions []controllerPkg.FetcherExecution) {
+	for _, execution := range executions {
+		fetcherName := string(execution.Name)
+		runStatus := "success"
+
+		for _, query := range execution.QueryInfos {
+			if query == nil {
+				continue
+			}
+
+			queryStatus := "success"
+			if !query.Success {
+				queryStatus = "failure"
+				runStatus = "failure"
+			}
+
+			m.QueriesCounter.With(prometheus.Labels{
+				"fetcher": fetcherName,
+				"chain":   query.Chain,
+				"status":  queryStatus,
+			}).Inc()
+
+			m.QueryDurationHistogram.With(prometheus.Labels{
+				"fetcher": fetcherName,
+				"chain":   query.Chain,
+			}).Observe(query.Duration.Seconds())
+		}
+
+		m.DurationHistogram.With(prometheus.Labels{
+			"fetcher": fetcherName,
+		}).Observe(execution.Duration.Seconds())
+
+		m.RunsCounter.With(prometheus.Labels{
+			"fetcher": fetcherName,
+			"status":  runStatus,
+		}).Inc()
+	}
+}
+
+func (m *FetcherExecutionMetrics) GetMetrics() []prometheus.Collector {
+	return []prometheus.Collector{
+		m.DurationHistogram,
+		m.QueryDurationHistogram,
+		m.RunsCounter,
+		m.QueriesCounter,
+	}
+}
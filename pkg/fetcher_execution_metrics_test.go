@@ -0,0 +1,73 @@
+package pkg
+
+import (
+	"main/pkg/constants"
+	controllerPkg "main/pkg/controller"
+	"main/pkg/types"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFetcherExecutionMetrics(t *testing.T) {
+	t.Parallel()
+
+	generator := NewFetcherExecutionMetrics([]constants.FetcherName{
+		constants.FetcherNameDelegations,
+		constants.FetcherNameSupply,
+	})
+	generator.Record([]controllerPkg.FetcherExecution{
+		{
+			Name:     constants.FetcherNameDelegations,
+			Duration: 2 * time.Second,
+			QueryInfos: []*types.QueryInfo{
+				{Chain: "cosmos", Success: true, Duration: time.Second},
+				{Chain: "cosmos", Success: false, Duration: time.Second},
+				nil,
+			},
+		},
+		{
+			Name:       constants.FetcherNameSupply,
+			Duration:   time.Second,
+			QueryInfos: []*types.QueryInfo{{Chain: "cosmos", Success: true, Duration: time.Second}},
+		},
+	})
+
+	metrics := generator.GetMetrics()
+	assert.Len(t, metrics, 4)
+
+	runsCounter, ok := metrics[2].(*prometheus.CounterVec)
+	assert.True(t, ok)
+	assert.Equal(t, 4, testutil.CollectAndCount(runsCounter))
+	assert.InDelta(t, 1, testutil.ToFloat64(runsCounter.With(prometheus.Labels{
+		"fetcher": "delegations",
+		"status":  "failure",
+	})), 0.01)
+	assert.Zero(t, testutil.ToFloat64(runsCounter.With(prometheus.Labels{
+		"fetcher": "delegations",
+		"status":  "success",
+	})))
+	assert.InDelta(t, 1, testutil.ToFloat64(runsCounter.With(prometheus.Labels{
+		"fetcher": "supply",
+		"status":  "success",
+	})), 0.01)
+
+	queriesCounter, ok := metrics[3].(*prometheus.CounterVec)
+	assert.True(t, ok)
+	assert.InDelta(t, 1, testutil.ToFloat64(queriesCounter.With(prometheus.Labels{
+		"fetcher": "delegations",
+		"chain":   "cosmos",
+		"status":  "failure",
+	})), 0.01)
+	assert.InDelta(t, 1, testutil.ToFloat64(queriesCounter.With(prometheus.Labels{
+		"fetcher": "delegations",
+		"chain":   "cosmos",
+		"status":  "success",
+	})), 0.01)
+
+	assert.Equal(t, 2, testutil.CollectAndCount(metrics[0]))
+	assert.Equal(t, 2, testutil.CollectAndCount(metrics[1]))
+}
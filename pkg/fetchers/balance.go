@@ -4,6 +4,7 @@ import (
 	"context"
 	"main/pkg/config"
 	"main/pkg/constants"
+	statePkg "main/pkg/state"
 	"main/pkg/tendermint"
 	"main/pkg/types"
 	"main/pkg/utils"
@@ -18,18 +19,14 @@ type BalanceFetcher struct {
 	Chains []*config.Chain
 	RPCs   map[string]*tendermint.RPCWithConsumers
 	Tracer trace.Tracer
-
-	wg    sync.WaitGroup
-	mutex sync.Mutex
-
-	queryInfos  []*types.QueryInfo
-	allBalances map[string]map[string][]types.Amount
 }
 
 type BalanceData struct {
 	Balances map[string]map[string][]types.Amount
 }
 
+var BalanceKey = statePkg.NewKey[BalanceData](constants.FetcherNameBalance)
+
 func NewBalanceFetcher(
 	logger *zerolog.Logger,
 	chains []*config.Chain,
@@ -51,25 +48,53 @@ func (q *BalanceFetcher) Dependencies() []constants.FetcherName {
 func (q *BalanceFetcher) Fetch(
 	ctx context.Context,
 	data ...any,
-) (any, []*types.QueryInfo) {
-	q.queryInfos = []*types.QueryInfo{}
-	q.allBalances = map[string]map[string][]types.Amount{}
+) (BalanceData, []*types.QueryInfo) {
+	queryInfos := []*types.QueryInfo{}
+	allBalances := map[string]map[string][]types.Amount{}
 
 	for _, chain := range q.Chains {
-		q.allBalances[chain.Name] = map[string][]types.Amount{}
+		allBalances[chain.Name] = map[string][]types.Amount{}
 		for _, consumerChain := range chain.ConsumerChains {
-			q.allBalances[consumerChain.Name] = map[string][]types.Amount{}
+			allBalances[consumerChain.Name] = map[string][]types.Amount{}
 		}
 	}
 
+	var (
+		wg    sync.WaitGroup
+		mutex sync.Mutex
+	)
+
+	processChain := func(
+		chainName string,
+		chainBechWalletPrefix string,
+		validator string,
+		rpc *tendermint.RPC,
+	) {
+		defer wg.Done()
+
+		balances, query := q.fetchBalance(ctx, chainName, chainBechWalletPrefix, validator, rpc)
+
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		if query != nil {
+			queryInfos = append(queryInfos, query)
+		}
+
+		if balances == nil {
+			return
+		}
+
+		allBalances[chainName][validator] = balances
+	}
+
 	for _, chain := range q.Chains {
 		rpc := q.RPCs[chain.Name]
 
 		for _, validator := range chain.Validators {
-			q.wg.Add(1 + len(chain.ConsumerChains))
+			wg.Add(1 + len(chain.ConsumerChains))
 
-			go q.processChain(
-				ctx,
+			go processChain(
 				chain.Name,
 				chain.BechWalletPrefix,
 				validator.Address,
@@ -77,39 +102,34 @@ func (q *BalanceFetcher) Fetch(
 			)
 
 			for consumerIndex, consumerChain := range chain.ConsumerChains {
-				consumerRPC := rpc.Consumers[consumerIndex]
-
-				go q.processChain(
-					ctx,
+				go processChain(
 					consumerChain.Name,
 					consumerChain.BechWalletPrefix,
 					validator.Address,
-					consumerRPC,
+					rpc.Consumers[consumerIndex],
 				)
 			}
 		}
 	}
 
-	q.wg.Wait()
+	wg.Wait()
 
-	return BalanceData{Balances: q.allBalances}, q.queryInfos
+	return BalanceData{Balances: allBalances}, queryInfos
 }
 
 func (q *BalanceFetcher) Name() constants.FetcherName {
 	return constants.FetcherNameBalance
 }
 
-func (q *BalanceFetcher) processChain(
+func (q *BalanceFetcher) fetchBalance(
 	ctx context.Context,
 	chainName string,
 	chainBechWalletPrefix string,
 	validator string,
 	rpc *tendermint.RPC,
-) {
-	defer q.wg.Done()
-
+) ([]types.Amount, *types.QueryInfo) {
 	if chainBechWalletPrefix == "" {
-		return
+		return nil, nil
 	}
 
 	wallet, err := utils.ChangeBech32Prefix(validator, chainBechWalletPrefix)
@@ -120,18 +140,10 @@ func (q *BalanceFetcher) processChain(
 			Str("address", validator).
 			Msg("Error converting validator address")
 
-		return
+		return nil, nil
 	}
 
 	balances, query, err := rpc.GetWalletBalance(wallet, ctx)
-
-	q.mutex.Lock()
-	defer q.mutex.Unlock()
-
-	if query != nil {
-		q.queryInfos = append(q.queryInfos, query)
-	}
-
 	if err != nil {
 		q.Logger.Error().
 			Err(err).
@@ -139,12 +151,8 @@ func (q *BalanceFetcher) processChain(
 			Str("address", validator).
 			Msg("Error querying for validator wallet balance")
 
-		return
-	}
-
-	if balances == nil {
-		return
+		return nil, query
 	}
 
-	q.allBalances[chainName][validator] = balances
+	return balances, query
 }
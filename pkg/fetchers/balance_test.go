@@ -70,12 +70,9 @@ func TestBalanceFetcherNoBechWalletPrefix(t *testing.T) {
 		RPCs:   rpcs,
 		Tracer: tracing.InitNoopTracer(),
 	}
-	data, queries := fetcher.Fetch(context.Background())
+	balanceData, queries := fetcher.Fetch(context.Background())
 	assert.Empty(t, queries)
 
-	balanceData, ok := data.(BalanceData)
-	assert.True(t, ok)
-
 	chainData, ok := balanceData.Balances["chain"]
 	assert.True(t, ok)
 	assert.Empty(t, chainData)
@@ -104,12 +101,9 @@ func TestBalanceFetcherInvalidBechWalletPrefix(t *testing.T) {
 		RPCs:   rpcs,
 		Tracer: tracing.InitNoopTracer(),
 	}
-	data, queries := fetcher.Fetch(context.Background())
+	balanceData, queries := fetcher.Fetch(context.Background())
 	assert.Empty(t, queries)
 
-	balanceData, ok := data.(BalanceData)
-	assert.True(t, ok)
-
 	chainData, ok := balanceData.Balances["chain"]
 	assert.True(t, ok)
 	assert.Empty(t, chainData)
@@ -139,12 +133,9 @@ func TestBalanceFetcherQueryDisabled(t *testing.T) {
 		RPCs:   rpcs,
 		Tracer: tracing.InitNoopTracer(),
 	}
-	data, queries := fetcher.Fetch(context.Background())
+	balanceData, queries := fetcher.Fetch(context.Background())
 	assert.Empty(t, queries)
 
-	balanceData, ok := data.(BalanceData)
-	assert.True(t, ok)
-
 	chainData, ok := balanceData.Balances["chain"]
 	assert.True(t, ok)
 	assert.Empty(t, chainData)
@@ -158,7 +149,7 @@ func TestBalanceFetcherQueryError(t *testing.T) {
 
 	httpmock.RegisterResponder(
 		"GET",
-		"https://api.cosmos.quokkastake.io/cosmos/bank/v1beta1/balances/cosmos1xqz9pemz5e5zycaa89kys5aw6m8rhgsvtp9lt2",
+		"https://api.cosmos.quokkastake.io/cosmos/bank/v1beta1/balances/cosmos1xqz9pemz5e5zycaa89kys5aw6m8rhgsvtp9lt2?pagination.limit=1000",
 		httpmock.NewErrorResponder(errors.New("error")),
 	)
 
@@ -182,13 +173,10 @@ func TestBalanceFetcherQueryError(t *testing.T) {
 		RPCs:   rpcs,
 		Tracer: tracing.InitNoopTracer(),
 	}
-	data, queries := fetcher.Fetch(context.Background())
+	balanceData, queries := fetcher.Fetch(context.Background())
 	assert.Len(t, queries, 1)
 	assert.False(t, queries[0].Success)
 
-	balanceData, ok := data.(BalanceData)
-	assert.True(t, ok)
-
 	chainData, ok := balanceData.Balances["chain"]
 	assert.True(t, ok)
 	assert.Empty(t, chainData)
@@ -202,7 +190,7 @@ func TestBalanceFetcherNodeError(t *testing.T) {
 
 	httpmock.RegisterResponder(
 		"GET",
-		"https://api.cosmos.quokkastake.io/cosmos/bank/v1beta1/balances/cosmos1xqz9pemz5e5zycaa89kys5aw6m8rhgsvtp9lt2",
+		"https://api.cosmos.quokkastake.io/cosmos/bank/v1beta1/balances/cosmos1xqz9pemz5e5zycaa89kys5aw6m8rhgsvtp9lt2?pagination.limit=1000",
 		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("error.json")),
 	)
 
@@ -226,13 +214,10 @@ func TestBalanceFetcherNodeError(t *testing.T) {
 		RPCs:   rpcs,
 		Tracer: tracing.InitNoopTracer(),
 	}
-	data, queries := fetcher.Fetch(context.Background())
+	balanceData, queries := fetcher.Fetch(context.Background())
 	assert.Len(t, queries, 1)
 	assert.False(t, queries[0].Success)
 
-	balanceData, ok := data.(BalanceData)
-	assert.True(t, ok)
-
 	chainData, ok := balanceData.Balances["chain"]
 	assert.True(t, ok)
 	assert.Empty(t, chainData)
@@ -246,7 +231,7 @@ func TestBalanceFetcherQuerySuccess(t *testing.T) {
 
 	httpmock.RegisterResponder(
 		"GET",
-		"https://api.cosmos.quokkastake.io/cosmos/bank/v1beta1/balances/cosmos1xqz9pemz5e5zycaa89kys5aw6m8rhgsvtp9lt2",
+		"https://api.cosmos.quokkastake.io/cosmos/bank/v1beta1/balances/cosmos1xqz9pemz5e5zycaa89kys5aw6m8rhgsvtp9lt2?pagination.limit=1000",
 		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("balances.json")),
 	)
 
@@ -270,13 +255,10 @@ func TestBalanceFetcherQuerySuccess(t *testing.T) {
 		RPCs:   rpcs,
 		Tracer: tracing.InitNoopTracer(),
 	}
-	data, queries := fetcher.Fetch(context.Background())
+	balanceData, queries := fetcher.Fetch(context.Background())
 	assert.Len(t, queries, 1)
 	assert.True(t, queries[0].Success)
 
-	balanceData, ok := data.(BalanceData)
-	assert.True(t, ok)
-
 	chainData, ok := balanceData.Balances["chain"]
 	assert.True(t, ok)
 
@@ -295,7 +277,7 @@ func TestBalanceFetcherConsumer(t *testing.T) {
 
 	httpmock.RegisterResponder(
 		"GET",
-		"https://api.neutron.quokkastake.io/cosmos/bank/v1beta1/balances/neutron1xqz9pemz5e5zycaa89kys5aw6m8rhgsv07va3d",
+		"https://api.neutron.quokkastake.io/cosmos/bank/v1beta1/balances/neutron1xqz9pemz5e5zycaa89kys5aw6m8rhgsv07va3d?pagination.limit=1000",
 		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("balances.json")),
 	)
 
@@ -327,13 +309,10 @@ func TestBalanceFetcherConsumer(t *testing.T) {
 		RPCs:   rpcs,
 		Tracer: tracing.InitNoopTracer(),
 	}
-	data, queries := fetcher.Fetch(context.Background())
+	balanceData, queries := fetcher.Fetch(context.Background())
 	assert.Len(t, queries, 1)
 	assert.True(t, queries[0].Success)
 
-	balanceData, ok := data.(BalanceData)
-	assert.True(t, ok)
-
 	chainData, ok := balanceData.Balances["consumer"]
 	assert.True(t, ok)
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"main/pkg/config"
 	"main/pkg/constants"
+	statePkg "main/pkg/state"
 	"main/pkg/tendermint"
 	"main/pkg/types"
 	"sync"
@@ -23,6 +24,8 @@ type CommissionData struct {
 	Commissions map[string]map[string][]types.Amount
 }
 
+var CommissionKey = statePkg.NewKey[CommissionData](constants.FetcherNameCommission)
+
 func NewCommissionFetcher(
 	logger *zerolog.Logger,
 	chains []*config.Chain,
@@ -44,7 +47,7 @@ func (q *CommissionFetcher) Dependencies() []constants.FetcherName {
 func (q *CommissionFetcher) Fetch(
 	ctx context.Context,
 	data ...any,
-) (any, []*types.QueryInfo) {
+) (CommissionData, []*types.QueryInfo) {
 	var queryInfos []*types.QueryInfo
 
 	allCommissions := map[string]map[string][]types.Amount{}
@@ -72,12 +72,9 @@ func TestCommissionFetcherQueryDisabled(t *testing.T) {
 		RPCs:   rpcs,
 		Tracer: tracing.InitNoopTracer(),
 	}
-	data, queries := fetcher.Fetch(context.Background())
+	balanceData, queries := fetcher.Fetch(context.Background())
 	assert.Empty(t, queries)
 
-	balanceData, ok := data.(CommissionData)
-	assert.True(t, ok)
-
 	chainData, ok := balanceData.Commissions["chain"]
 	assert.True(t, ok)
 	assert.Empty(t, chainData)
@@ -115,13 +112,10 @@ func TestCommissionFetcherQueryError(t *testing.T) {
 		RPCs:   rpcs,
 		Tracer: tracing.InitNoopTracer(),
 	}
-	data, queries := fetcher.Fetch(context.Background())
+	balanceData, queries := fetcher.Fetch(context.Background())
 	assert.Len(t, queries, 1)
 	assert.False(t, queries[0].Success)
 
-	balanceData, ok := data.(CommissionData)
-	assert.True(t, ok)
-
 	chainData, ok := balanceData.Commissions["chain"]
 	assert.True(t, ok)
 	assert.Empty(t, chainData)
@@ -159,13 +153,10 @@ func TestCommissionFetcherNodeError(t *testing.T) {
 		RPCs:   rpcs,
 		Tracer: tracing.InitNoopTracer(),
 	}
-	data, queries := fetcher.Fetch(context.Background())
+	balanceData, queries := fetcher.Fetch(context.Background())
 	assert.Len(t, queries, 1)
 	assert.False(t, queries[0].Success)
 
-	balanceData, ok := data.(CommissionData)
-	assert.True(t, ok)
-
 	chainData, ok := balanceData.Commissions["chain"]
 	assert.True(t, ok)
 	assert.Empty(t, chainData)
@@ -203,13 +194,10 @@ func TestCommissionFetcherQuerySuccess(t *testing.T) {
 		RPCs:   rpcs,
 		Tracer: tracing.InitNoopTracer(),
 	}
-	data, queries := fetcher.Fetch(context.Background())
+	balanceData, queries := fetcher.Fetch(context.Background())
 	assert.Len(t, queries, 1)
 	assert.True(t, queries[0].Success)
 
-	balanceData, ok := data.(CommissionData)
-	assert.True(t, ok)
-
 	chainData, ok := balanceData.Commissions["chain"]
 	assert.True(t, ok)
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"main/pkg/config"
 	"main/pkg/constants"
+	statePkg "main/pkg/state"
 	"main/pkg/tendermint"
 	"main/pkg/types"
 	"sync"
@@ -17,18 +18,14 @@ type ConsumerCommissionFetcher struct {
 	Chains []*config.Chain
 	RPCs   map[string]*tendermint.RPCWithConsumers
 	Tracer trace.Tracer
-
-	wg    sync.WaitGroup
-	mutex sync.Mutex
-
-	queryInfos []*types.QueryInfo
-	data       map[string]map[string]*types.ConsumerCommissionResponse
 }
 
 type ConsumerCommissionData struct {
 	Commissions map[string]map[string]*types.ConsumerCommissionResponse
 }
 
+var ConsumerCommissionKey = statePkg.NewKey[ConsumerCommissionData](constants.FetcherNameConsumerCommission)
+
 func NewConsumerCommissionFetcher(
 	logger *zerolog.Logger,
 	chains []*config.Chain,
@@ -49,13 +46,48 @@ func (f *ConsumerCommissionFetcher) Dependencies() []constants.FetcherName {
 func (f *ConsumerCommissionFetcher) Fetch(
 	ctx context.Context,
 	data ...any,
-) (any, []*types.QueryInfo) {
-	f.queryInfos = []*types.QueryInfo{}
-	f.data = map[string]map[string]*types.ConsumerCommissionResponse{}
+) (ConsumerCommissionData, []*types.QueryInfo) {
+	queryInfos := []*types.QueryInfo{}
+	allCommissions := map[string]map[string]*types.ConsumerCommissionResponse{}
 
 	for _, chain := range f.Chains {
 		for _, consumer := range chain.ConsumerChains {
-			f.data[consumer.Name] = map[string]*types.ConsumerCommissionResponse{}
+			allCommissions[consumer.Name] = map[string]*types.ConsumerCommissionResponse{}
+		}
+	}
+
+	var (
+		wg    sync.WaitGroup
+		mutex sync.Mutex
+	)
+
+	processChain := func(
+		rpc *tendermint.RPC,
+		chain *config.ConsumerChain,
+		validator config.Validator,
+	) {
+		defer wg.Done()
+
+		commission, queryInfo, err := rpc.GetConsumerCommission(ctx, validator.ConsensusAddress, chain.ConsumerID)
+
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		if queryInfo != nil {
+			queryInfos = append(queryInfos, queryInfo)
+		}
+
+		if err != nil {
+			f.Logger.Error().
+				Err(err).
+				Str("chain", chain.Name).
+				Msg("Error querying consumer commission")
+
+			return
+		}
+
+		if commission != nil {
+			allCommissions[chain.Name][validator.Address] = commission
 		}
 	}
 
@@ -68,49 +100,18 @@ func (f *ConsumerCommissionFetcher) Fetch(
 			rpc := f.RPCs[chain.Name]
 
 			for _, consumerChain := range chain.ConsumerChains {
-				f.wg.Add(1)
+				wg.Add(1)
 
-				go f.processChain(ctx, rpc.RPC, consumerChain, validator)
+				go processChain(rpc.RPC, consumerChain, validator)
 			}
 		}
 	}
 
-	f.wg.Wait()
+	wg.Wait()
 
-	return ConsumerCommissionData{Commissions: f.data}, f.queryInfos
+	return ConsumerCommissionData{Commissions: allCommissions}, queryInfos
 }
 
 func (f *ConsumerCommissionFetcher) Name() constants.FetcherName {
 	return constants.FetcherNameConsumerCommission
 }
-
-func (f *ConsumerCommissionFetcher) processChain(
-	ctx context.Context,
-	rpc *tendermint.RPC,
-	chain *config.ConsumerChain,
-	validator config.Validator,
-) {
-	defer f.wg.Done()
-
-	commission, queryInfo, err := rpc.GetConsumerCommission(ctx, validator.ConsensusAddress, chain.ConsumerID)
-
-	f.mutex.Lock()
-	defer f.mutex.Unlock()
-
-	if queryInfo != nil {
-		f.queryInfos = append(f.queryInfos, queryInfo)
-	}
-
-	if err != nil {
-		f.Logger.Error().
-			Err(err).
-			Str("chain", chain.Name).
-			Msg("Error querying consumer commission")
-
-		return
-	}
-
-	if commission != nil {
-		f.data[chain.Name][validator.Address] = commission
-	}
-}
@@ -79,12 +79,9 @@ func TestConsumerCommissionFetcherNoConsensusAddress(t *testing.T) {
 		RPCs:   rpcs,
 		Tracer: tracing.InitNoopTracer(),
 	}
-	data, queries := fetcher.Fetch(context.Background())
+	commissionsData, queries := fetcher.Fetch(context.Background())
 	assert.Empty(t, queries)
 
-	commissionsData, ok := data.(ConsumerCommissionData)
-	assert.True(t, ok)
-
 	chainData, ok := commissionsData.Commissions["consumer"]
 	assert.True(t, ok)
 	assert.Empty(t, chainData)
@@ -123,12 +120,9 @@ func TestConsumerCommissionFetcherQueryDisabled(t *testing.T) {
 		RPCs:   rpcs,
 		Tracer: tracing.InitNoopTracer(),
 	}
-	data, queries := fetcher.Fetch(context.Background())
+	commissionsData, queries := fetcher.Fetch(context.Background())
 	assert.Empty(t, queries)
 
-	commissionsData, ok := data.(ConsumerCommissionData)
-	assert.True(t, ok)
-
 	chainData, ok := commissionsData.Commissions["consumer"]
 	assert.True(t, ok)
 	assert.Empty(t, chainData)
@@ -175,13 +169,10 @@ func TestConsumerCommissionFetcherQueryError(t *testing.T) {
 		RPCs:   rpcs,
 		Tracer: tracing.InitNoopTracer(),
 	}
-	data, queries := fetcher.Fetch(context.Background())
+	commissionsData, queries := fetcher.Fetch(context.Background())
 	assert.Len(t, queries, 1)
 	assert.False(t, queries[0].Success)
 
-	commissionsData, ok := data.(ConsumerCommissionData)
-	assert.True(t, ok)
-
 	chainData, ok := commissionsData.Commissions["consumer"]
 	assert.True(t, ok)
 	assert.Empty(t, chainData)
@@ -228,13 +219,10 @@ func TestConsumerCommissionFetcherNodeError(t *testing.T) {
 		RPCs:   rpcs,
 		Tracer: tracing.InitNoopTracer(),
 	}
-	data, queries := fetcher.Fetch(context.Background())
+	commissionsData, queries := fetcher.Fetch(context.Background())
 	assert.Len(t, queries, 1)
 	assert.False(t, queries[0].Success)
 
-	commissionsData, ok := data.(ConsumerCommissionData)
-	assert.True(t, ok)
-
 	chainData, ok := commissionsData.Commissions["consumer"]
 	assert.True(t, ok)
 	assert.Empty(t, chainData)
@@ -281,13 +269,10 @@ func TestConsumerCommissionFetcherQuerySuccess(t *testing.T) {
 		RPCs:   rpcs,
 		Tracer: tracing.InitNoopTracer(),
 	}
-	data, queries := fetcher.Fetch(context.Background())
+	commissionsData, queries := fetcher.Fetch(context.Background())
 	assert.Len(t, queries, 1)
 	assert.True(t, queries[0].Success)
 
-	commissionsData, ok := data.(ConsumerCommissionData)
-	assert.True(t, ok)
-
 	chainData, ok := commissionsData.Commissions["consumer"]
 	assert.True(t, ok)
 	assert.NotNil(t, chainData)
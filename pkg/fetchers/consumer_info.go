@@ -4,6 +4,7 @@ import (
 	"context"
 	"main/pkg/config"
 	"main/pkg/constants"
+	statePkg "main/pkg/state"
 	"main/pkg/tendermint"
 	"main/pkg/types"
 	"sync"
@@ -17,18 +18,14 @@ type ConsumerInfoFetcher struct {
 	Chains []*config.Chain
 	RPCs   map[string]*tendermint.RPCWithConsumers
 	Tracer trace.Tracer
-
-	wg    sync.WaitGroup
-	mutex sync.Mutex
-
-	queryInfos []*types.QueryInfo
-	allInfos   map[string]map[string]types.ConsumerChainInfo
 }
 
 type ConsumerInfoData struct {
 	Info map[string]map[string]types.ConsumerChainInfo
 }
 
+var ConsumerInfoKey = statePkg.NewKey[ConsumerInfoData](constants.FetcherNameConsumerInfo)
+
 func NewConsumerInfoFetcher(
 	logger *zerolog.Logger,
 	chains []*config.Chain,
@@ -49,62 +46,63 @@ func (f *ConsumerInfoFetcher) Dependencies() []constants.FetcherName {
 func (f *ConsumerInfoFetcher) Fetch(
 	ctx context.Context,
 	data ...any,
-) (any, []*types.QueryInfo) {
-	f.queryInfos = []*types.QueryInfo{}
-	f.allInfos = map[string]map[string]types.ConsumerChainInfo{}
+) (ConsumerInfoData, []*types.QueryInfo) {
+	queryInfos := []*types.QueryInfo{}
+	allInfos := map[string]map[string]types.ConsumerChainInfo{}
 
-	f.wg.Add(len(f.Chains))
+	var (
+		wg    sync.WaitGroup
+		mutex sync.Mutex
+	)
 
-	for _, chain := range f.Chains {
-		rpc := f.RPCs[chain.Name]
-		go f.processChain(ctx, rpc.RPC, chain)
-	}
+	processChain := func(rpc *tendermint.RPC, chain *config.Chain) {
+		defer wg.Done()
 
-	f.wg.Wait()
+		if !chain.IsProvider.Bool {
+			return
+		}
 
-	return ConsumerInfoData{Info: f.allInfos}, f.queryInfos
-}
+		allInfosList, queryInfo, err := rpc.GetConsumerInfo(ctx)
 
-func (f *ConsumerInfoFetcher) Name() constants.FetcherName {
-	return constants.FetcherNameConsumerInfo
-}
+		mutex.Lock()
+		defer mutex.Unlock()
 
-func (f *ConsumerInfoFetcher) processChain(
-	ctx context.Context,
-	rpc *tendermint.RPC,
-	chain *config.Chain,
-) {
-	defer f.wg.Done()
+		if queryInfo != nil {
+			queryInfos = append(queryInfos, queryInfo)
+		}
 
-	if !chain.IsProvider.Bool {
-		return
-	}
+		if err != nil {
+			f.Logger.Error().
+				Err(err).
+				Str("chain", chain.Name).
+				Msg("Error querying consumer info")
+
+			return
+		}
 
-	allInfosList, queryInfo, err := rpc.GetConsumerInfo(ctx)
+		if allInfosList == nil {
+			return
+		}
 
-	f.mutex.Lock()
-	defer f.mutex.Unlock()
+		allInfos[chain.Name] = map[string]types.ConsumerChainInfo{}
 
-	if queryInfo != nil {
-		f.queryInfos = append(f.queryInfos, queryInfo)
+		for _, consumerInfo := range allInfosList.Chains {
+			allInfos[chain.Name][consumerInfo.ConsumerID] = consumerInfo
+		}
 	}
 
-	if err != nil {
-		f.Logger.Error().
-			Err(err).
-			Str("chain", chain.Name).
-			Msg("Error querying consumer info")
+	wg.Add(len(f.Chains))
 
-		return
+	for _, chain := range f.Chains {
+		rpc := f.RPCs[chain.Name]
+		go processChain(rpc.RPC, chain)
 	}
 
-	if allInfosList == nil {
-		return
-	}
+	wg.Wait()
 
-	f.allInfos[chain.Name] = map[string]types.ConsumerChainInfo{}
+	return ConsumerInfoData{Info: allInfos}, queryInfos
+}
 
-	for _, consumerInfo := range allInfosList.Chains {
-		f.allInfos[chain.Name][consumerInfo.ConsumerID] = consumerInfo
-	}
+func (f *ConsumerInfoFetcher) Name() constants.FetcherName {
+	return constants.FetcherNameConsumerInfo
 }
@@ -73,11 +73,9 @@ func TestConsumerInfoFetcherNotProvider(t *testing.T) {
 		RPCs:   rpcs,
 		Tracer: tracing.InitNoopTracer(),
 	}
-	data, queries := fetcher.Fetch(context.Background())
+	consumerData, queries := fetcher.Fetch(context.Background())
 	assert.Empty(t, queries)
 
-	consumerData, ok := data.(ConsumerInfoData)
-	assert.True(t, ok)
 	assert.Empty(t, consumerData.Info)
 }
 
@@ -106,11 +104,9 @@ func TestConsumerInfoFetcherQueryDisabled(t *testing.T) {
 		RPCs:   rpcs,
 		Tracer: tracing.InitNoopTracer(),
 	}
-	data, queries := fetcher.Fetch(context.Background())
+	consumerData, queries := fetcher.Fetch(context.Background())
 	assert.Empty(t, queries)
 
-	consumerData, ok := data.(ConsumerInfoData)
-	assert.True(t, ok)
 	assert.Empty(t, consumerData.Info)
 }
 
@@ -147,12 +143,10 @@ func TestConsumerInfoFetcherQueryError(t *testing.T) {
 		RPCs:   rpcs,
 		Tracer: tracing.InitNoopTracer(),
 	}
-	data, queries := fetcher.Fetch(context.Background())
+	consumerData, queries := fetcher.Fetch(context.Background())
 	assert.Len(t, queries, 1)
 	assert.False(t, queries[0].Success)
 
-	consumerData, ok := data.(ConsumerInfoData)
-	assert.True(t, ok)
 	assert.Empty(t, consumerData.Info)
 }
 
@@ -189,12 +183,10 @@ func TestConsumerInfoFetcherNodeError(t *testing.T) {
 		RPCs:   rpcs,
 		Tracer: tracing.InitNoopTracer(),
 	}
-	data, queries := fetcher.Fetch(context.Background())
+	consumerData, queries := fetcher.Fetch(context.Background())
 	assert.Len(t, queries, 1)
 	assert.False(t, queries[0].Success)
 
-	consumerData, ok := data.(ConsumerInfoData)
-	assert.True(t, ok)
 	assert.Empty(t, consumerData.Info)
 }
 
@@ -231,13 +223,10 @@ func TestConsumerInfoFetcherQuerySuccess(t *testing.T) {
 		RPCs:   rpcs,
 		Tracer: tracing.InitNoopTracer(),
 	}
-	data, queries := fetcher.Fetch(context.Background())
+	consumerData, queries := fetcher.Fetch(context.Background())
 	assert.Len(t, queries, 1)
 	assert.True(t, queries[0].Success)
 
-	consumerData, ok := data.(ConsumerInfoData)
-	assert.True(t, ok)
-
 	chainData, ok := consumerData.Info["chain"]
 	assert.True(t, ok)
 	assert.Len(t, chainData, 1)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"main/pkg/config"
 	"main/pkg/constants"
+	statePkg "main/pkg/state"
 	"main/pkg/tendermint"
 	"main/pkg/types"
 	"sync"
@@ -17,18 +18,14 @@ type ConsumerValidatorsFetcher struct {
 	Chains []*config.Chain
 	RPCs   map[string]*tendermint.RPCWithConsumers
 	Tracer trace.Tracer
-
-	wg    sync.WaitGroup
-	mutex sync.Mutex
-
-	queryInfos    []*types.QueryInfo
-	allValidators map[string]*types.ConsumerValidatorsResponse
 }
 
 type ConsumerValidatorsData struct {
 	Validators map[string]*types.ConsumerValidatorsResponse
 }
 
+var ConsumerValidatorsKey = statePkg.NewKey[ConsumerValidatorsData](constants.FetcherNameConsumerValidators)
+
 func NewConsumerValidatorsFetcher(
 	logger *zerolog.Logger,
 	chains []*config.Chain,
@@ -50,53 +47,54 @@ func (f *ConsumerValidatorsFetcher) Dependencies() []constants.FetcherName {
 func (f *ConsumerValidatorsFetcher) Fetch(
 	ctx context.Context,
 	data ...any,
-) (any, []*types.QueryInfo) {
-	f.queryInfos = []*types.QueryInfo{}
-	f.allValidators = map[string]*types.ConsumerValidatorsResponse{}
+) (ConsumerValidatorsData, []*types.QueryInfo) {
+	queryInfos := []*types.QueryInfo{}
+	allValidators := map[string]*types.ConsumerValidatorsResponse{}
 
-	for _, chain := range f.Chains {
-		f.wg.Add(len(chain.ConsumerChains))
+	var (
+		wg    sync.WaitGroup
+		mutex sync.Mutex
+	)
 
-		rpc := f.RPCs[chain.Name]
+	processChain := func(rpc *tendermint.RPC, chain *config.ConsumerChain) {
+		defer wg.Done()
 
-		for _, consumerChain := range chain.ConsumerChains {
-			go f.processChain(ctx, rpc.RPC, consumerChain)
-		}
-	}
+		allValidatorsList, queryInfo, err := rpc.GetConsumerValidators(ctx, chain.ConsumerID)
 
-	f.wg.Wait()
+		mutex.Lock()
+		defer mutex.Unlock()
 
-	return ConsumerValidatorsData{Validators: f.allValidators}, f.queryInfos
-}
+		if queryInfo != nil {
+			queryInfos = append(queryInfos, queryInfo)
+		}
 
-func (f *ConsumerValidatorsFetcher) Name() constants.FetcherName {
-	return constants.FetcherNameConsumerValidators
-}
+		if err != nil {
+			f.Logger.Error().
+				Err(err).
+				Str("chain", chain.Name).
+				Msg("Error querying consumer validators")
 
-func (f *ConsumerValidatorsFetcher) processChain(
-	ctx context.Context,
-	rpc *tendermint.RPC,
-	chain *config.ConsumerChain,
-) {
-	defer f.wg.Done()
+			return
+		}
+
+		allValidators[chain.Name] = allValidatorsList
+	}
 
-	allValidatorsList, queryInfo, err := rpc.GetConsumerValidators(ctx, chain.ConsumerID)
+	for _, chain := range f.Chains {
+		wg.Add(len(chain.ConsumerChains))
 
-	f.mutex.Lock()
-	defer f.mutex.Unlock()
+		rpc := f.RPCs[chain.Name]
 
-	if queryInfo != nil {
-		f.queryInfos = append(f.queryInfos, queryInfo)
+		for _, consumerChain := range chain.ConsumerChains {
+			go processChain(rpc.RPC, consumerChain)
+		}
 	}
 
-	if err != nil {
-		f.Logger.Error().
-			Err(err).
-			Str("chain", chain.Name).
-			Msg("Error querying consumer validators")
+	wg.Wait()
 
-		return
-	}
+	return ConsumerValidatorsData{Validators: allValidators}, queryInfos
+}
 
-	f.allValidators[chain.Name] = allValidatorsList
+func (f *ConsumerValidatorsFetcher) Name() constants.FetcherName {
+	return constants.FetcherNameConsumerValidators
 }
@@ -78,12 +78,9 @@ func TestConsumerValidatorsFetcherQueryDisabled(t *testing.T) {
 		RPCs:   rpcs,
 		Tracer: tracing.InitNoopTracer(),
 	}
-	data, queries := fetcher.Fetch(context.Background())
+	validatorsData, queries := fetcher.Fetch(context.Background())
 	assert.Empty(t, queries)
 
-	validatorsData, ok := data.(ConsumerValidatorsData)
-	assert.True(t, ok)
-
 	chainData, ok := validatorsData.Validators["consumer"]
 	assert.True(t, ok)
 	assert.Empty(t, chainData)
@@ -127,12 +124,10 @@ func TestConsumerValidatorsFetcherQueryError(t *testing.T) {
 		RPCs:   rpcs,
 		Tracer: tracing.InitNoopTracer(),
 	}
-	data, queries := fetcher.Fetch(context.Background())
+	validatorsData, queries := fetcher.Fetch(context.Background())
 	assert.Len(t, queries, 1)
 	assert.False(t, queries[0].Success)
 
-	validatorsData, ok := data.(ConsumerValidatorsData)
-	assert.True(t, ok)
 	assert.Empty(t, validatorsData.Validators)
 }
 
@@ -174,12 +169,10 @@ func TestConsumerValidatorsFetcherNodeError(t *testing.T) {
 		RPCs:   rpcs,
 		Tracer: tracing.InitNoopTracer(),
 	}
-	data, queries := fetcher.Fetch(context.Background())
+	validatorsData, queries := fetcher.Fetch(context.Background())
 	assert.Len(t, queries, 1)
 	assert.False(t, queries[0].Success)
 
-	validatorsData, ok := data.(ConsumerValidatorsData)
-	assert.True(t, ok)
 	assert.Empty(t, validatorsData.Validators)
 }
 
@@ -221,13 +214,10 @@ func TestConsumerValidatorsFetcherQuerySuccess(t *testing.T) {
 		RPCs:   rpcs,
 		Tracer: tracing.InitNoopTracer(),
 	}
-	data, queries := fetcher.Fetch(context.Background())
+	validatorsData, queries := fetcher.Fetch(context.Background())
 	assert.Len(t, queries, 1)
 	assert.True(t, queries[0].Success)
 
-	validatorsData, ok := data.(ConsumerValidatorsData)
-	assert.True(t, ok)
-
 	chainData, ok := validatorsData.Validators["consumer"]
 	assert.True(t, ok)
 	assert.Len(t, chainData.Validators, 139)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"main/pkg/config"
 	"main/pkg/constants"
+	statePkg "main/pkg/state"
 	"main/pkg/tendermint"
 	"main/pkg/types"
 	"sync"
@@ -23,6 +24,8 @@ type DelegationsData struct {
 	Delegations map[string]map[string]uint64
 }
 
+var DelegationsKey = statePkg.NewKey[DelegationsData](constants.FetcherNameDelegations)
+
 func NewDelegationsFetcher(
 	logger *zerolog.Logger,
 	chains []*config.Chain,
@@ -44,7 +47,7 @@ func (q *DelegationsFetcher) Dependencies() []constants.FetcherName {
 func (q *DelegationsFetcher) Fetch(
 	ctx context.Context,
 	data ...any,
-) (any, []*types.QueryInfo) {
+) (DelegationsData, []*types.QueryInfo) {
 	var queryInfos []*types.QueryInfo
 
 	allDelegations := map[string]map[string]uint64{}
@@ -72,12 +72,9 @@ func TestDelegationsFetcherQueryDisabled(t *testing.T) {
 		RPCs:   rpcs,
 		Tracer: tracing.InitNoopTracer(),
 	}
-	data, queries := fetcher.Fetch(context.Background())
+	balanceData, queries := fetcher.Fetch(context.Background())
 	assert.Empty(t, queries)
 
-	balanceData, ok := data.(DelegationsData)
-	assert.True(t, ok)
-
 	chainData, ok := balanceData.Delegations["chain"]
 	assert.True(t, ok)
 	assert.Empty(t, chainData)
@@ -115,13 +112,10 @@ func TestDelegationsFetcherQueryError(t *testing.T) {
 		RPCs:   rpcs,
 		Tracer: tracing.InitNoopTracer(),
 	}
-	data, queries := fetcher.Fetch(context.Background())
+	balanceData, queries := fetcher.Fetch(context.Background())
 	assert.Len(t, queries, 1)
 	assert.False(t, queries[0].Success)
 
-	balanceData, ok := data.(DelegationsData)
-	assert.True(t, ok)
-
 	chainData, ok := balanceData.Delegations["chain"]
 	assert.True(t, ok)
 	assert.Empty(t, chainData)
@@ -159,13 +153,10 @@ func TestDelegationsFetcherNodeError(t *testing.T) {
 		RPCs:   rpcs,
 		Tracer: tracing.InitNoopTracer(),
 	}
-	data, queries := fetcher.Fetch(context.Background())
+	balanceData, queries := fetcher.Fetch(context.Background())
 	assert.Len(t, queries, 1)
 	assert.False(t, queries[0].Success)
 
-	balanceData, ok := data.(DelegationsData)
-	assert.True(t, ok)
-
 	chainData, ok := balanceData.Delegations["chain"]
 	assert.True(t, ok)
 	assert.Empty(t, chainData)
@@ -203,13 +194,10 @@ func TestDelegationsFetcherQuerySuccess(t *testing.T) {
 		RPCs:   rpcs,
 		Tracer: tracing.InitNoopTracer(),
 	}
-	data, queries := fetcher.Fetch(context.Background())
+	balanceData, queries := fetcher.Fetch(context.Background())
 	assert.Len(t, queries, 1)
 	assert.True(t, queries[0].Success)
 
-	balanceData, ok := data.(DelegationsData)
-	assert.True(t, ok)
-
 	chainData, ok := balanceData.Delegations["chain"]
 	assert.True(t, ok)
 
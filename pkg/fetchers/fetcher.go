@@ -3,15 +3,53 @@ package fetchers
 import (
 	"context"
 	"main/pkg/constants"
+	statePkg "main/pkg/state"
 	"main/pkg/types"
 )
 
+type TypedFetcher[T any] interface {
+	Fetch(ctx context.Context, data ...any) (T, []*types.QueryInfo)
+	Dependencies() []constants.FetcherName
+	Name() constants.FetcherName
+}
+
 type Fetcher interface {
 	Fetch(ctx context.Context, data ...any) (any, []*types.QueryInfo)
+	Store(state *statePkg.State, data any) error
 	Dependencies() []constants.FetcherName
 	Name() constants.FetcherName
 }
 
+type typedFetcher[T any] struct {
+	fetcher TypedFetcher[T]
+}
+
+func Typed[T any](fetcher TypedFetcher[T]) Fetcher {
+	return &typedFetcher[T]{fetcher: fetcher}
+}
+
+func (f *typedFetcher[T]) Fetch(ctx context.Context, data ...any) (any, []*types.QueryInfo) {
+	return f.fetcher.Fetch(ctx, data...)
+}
+
+func (f *typedFetcher[T]) Store(state *statePkg.State, data any) error {
+	converted, err := statePkg.Convert[T](data)
+	if err != nil {
+		return err
+	}
+
+	statePkg.Set(state, statePkg.Key[T]{Name: f.fetcher.Name()}, converted)
+	return nil
+}
+
+func (f *typedFetcher[T]) Dependencies() []constants.FetcherName {
+	return f.fetcher.Dependencies()
+}
+
+func (f *typedFetcher[T]) Name() constants.FetcherName {
+	return f.fetcher.Name()
+}
+
 type Fetchers []Fetcher
 
 func (f Fetchers) GetNames() []constants.FetcherName {
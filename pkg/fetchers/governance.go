@@ -0,0 +1,254 @@
+package fetchers
+
+import (
+	"context"
+	"main/pkg/config"
+	"main/pkg/constants"
+	statePkg "main/pkg/state"
+	"main/pkg/tendermint"
+	"main/pkg/types"
+	"main/pkg/utils"
+	"sync"
+
+	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type GovernanceFetcher struct {
+	Logger zerolog.Logger
+	Chains []*config.Chain
+	RPCs   map[string]*tendermint.RPCWithConsumers
+	Tracer trace.Tracer
+}
+
+type GovernanceData struct {
+	Proposals map[string][]types.GovProposal
+	// chain -> validator -> proposal ID -> vote, nil if not voted, missing if not fetched
+	Votes map[string]map[string]map[string]*types.GovVote
+}
+
+var GovernanceKey = statePkg.NewKey[GovernanceData](constants.FetcherNameGovernance)
+
+func NewGovernanceFetcher(
+	logger *zerolog.Logger,
+	chains []*config.Chain,
+	rpcs map[string]*tendermint.RPCWithConsumers,
+	tracer trace.Tracer,
+) *GovernanceFetcher {
+	return &GovernanceFetcher{
+		Logger: logger.With().Str("component", "governance_fetcher").Logger(),
+		Chains: chains,
+		RPCs:   rpcs,
+		Tracer: tracer,
+	}
+}
+
+func (q *GovernanceFetcher) Dependencies() []constants.FetcherName {
+	return []constants.FetcherName{}
+}
+
+func (q *GovernanceFetcher) Fetch(
+	ctx context.Context,
+	data ...any,
+) (GovernanceData, []*types.QueryInfo) {
+	queryInfos := []*types.QueryInfo{}
+	allProposals := map[string][]types.GovProposal{}
+	allVotes := map[string]map[string]map[string]*types.GovVote{}
+
+	var (
+		wg    sync.WaitGroup
+		mutex sync.Mutex
+	)
+
+	addQueryInfo := func(query *types.QueryInfo) {
+		if query == nil {
+			return
+		}
+
+		mutex.Lock()
+		queryInfos = append(queryInfos, query)
+		mutex.Unlock()
+	}
+
+	processChain := func(
+		chainName string,
+		chainBechWalletPrefix string,
+		validators []config.Validator,
+		rpc *tendermint.RPC,
+	) {
+		defer wg.Done()
+
+		proposals, version, ok := q.fetchProposals(ctx, chainName, rpc, addQueryInfo)
+		if !ok {
+			return
+		}
+
+		votes := q.fetchVotes(ctx, chainName, chainBechWalletPrefix, validators, version, proposals, rpc, addQueryInfo)
+
+		mutex.Lock()
+		allProposals[chainName] = proposals
+		allVotes[chainName] = votes
+		mutex.Unlock()
+	}
+
+	for _, chain := range q.Chains {
+		rpc := q.RPCs[chain.Name]
+
+		wg.Add(1 + len(chain.ConsumerChains))
+
+		go processChain(chain.Name, chain.BechWalletPrefix, chain.Validators, rpc.RPC)
+
+		for consumerIndex, consumerChain := range chain.ConsumerChains {
+			go processChain(
+				consumerChain.Name,
+				consumerChain.BechWalletPrefix,
+				chain.Validators,
+				rpc.Consumers[consumerIndex],
+			)
+		}
+	}
+
+	wg.Wait()
+
+	return GovernanceData{Proposals: allProposals, Votes: allVotes}, queryInfos
+}
+
+func (q *GovernanceFetcher) Name() constants.FetcherName {
+	return constants.FetcherNameGovernance
+}
+
+func (q *GovernanceFetcher) fetchProposals(
+	ctx context.Context,
+	chainName string,
+	rpc *tendermint.RPC,
+	addQueryInfo func(query *types.QueryInfo),
+) ([]types.GovProposal, constants.GovVersion, bool) {
+	version := constants.GovVersionV1
+
+	proposals, query, err := rpc.GetActiveProposals(ctx, version)
+	addQueryInfo(query)
+
+	if err != nil && query != nil && (query.ErrorClass == constants.QueryErrorClassResponseCode ||
+		query.ErrorClass == constants.QueryErrorClassClientError) {
+		q.Logger.Debug().
+			Err(err).
+			Str("chain", chainName).
+			Msg("Could not fetch gov/v1 proposals, falling back to gov/v1beta1")
+
+		version = constants.GovVersionV1beta1
+		proposals, query, err = rpc.GetActiveProposals(ctx, version)
+		addQueryInfo(query)
+	}
+
+	if err != nil {
+		q.Logger.Error().
+			Err(err).
+			Str("chain", chainName).
+			Msg("Error querying for active proposals")
+
+		return nil, version, false
+	}
+
+	if proposals == nil {
+		return nil, version, false
+	}
+
+	var wg sync.WaitGroup
+
+	for index := range proposals {
+		wg.Add(1)
+
+		go func(proposal *types.GovProposal) {
+			defer wg.Done()
+
+			tally, tallyQuery, tallyErr := rpc.GetProposalTally(ctx, version, proposal.ID)
+			addQueryInfo(tallyQuery)
+
+			if tallyErr != nil {
+				q.Logger.Error().
+					Err(tallyErr).
+					Str("chain", chainName).
+					Str("proposal", proposal.ID).
+					Msg("Error querying for proposal tally")
+				return
+			}
+
+			proposal.Tally = tally
+		}(&proposals[index])
+	}
+
+	wg.Wait()
+
+	return proposals, version, true
+}
+
+func (q *GovernanceFetcher) fetchVotes(
+	ctx context.Context,
+	chainName string,
+	chainBechWalletPrefix string,
+	validators []config.Validator,
+	version constants.GovVersion,
+	proposals []types.GovProposal,
+	rpc *tendermint.RPC,
+	addQueryInfo func(query *types.QueryInfo),
+) map[string]map[string]*types.GovVote {
+	votes := map[string]map[string]*types.GovVote{}
+
+	if chainBechWalletPrefix == "" {
+		return votes
+	}
+
+	var (
+		wg    sync.WaitGroup
+		mutex sync.Mutex
+	)
+
+	for _, validator := range validators {
+		wallet, err := utils.ChangeBech32Prefix(validator.Address, chainBechWalletPrefix)
+		if err != nil {
+			q.Logger.Error().
+				Err(err).
+				Str("chain", chainName).
+				Str("address", validator.Address).
+				Msg("Error converting validator address")
+			continue
+		}
+
+		validatorVotes := map[string]*types.GovVote{}
+		votes[validator.Address] = validatorVotes
+
+		for _, proposal := range proposals {
+			wg.Add(1)
+
+			go func(validator string, proposalID string) {
+				defer wg.Done()
+
+				vote, query, voteErr := rpc.GetProposalVote(ctx, version, proposalID, wallet)
+				addQueryInfo(query)
+
+				if voteErr != nil {
+					q.Logger.Error().
+						Err(voteErr).
+						Str("chain", chainName).
+						Str("address", validator).
+						Str("proposal", proposalID).
+						Msg("Error querying for validator vote")
+					return
+				}
+
+				// Votes query is disabled.
+				if query == nil {
+					return
+				}
+
+				mutex.Lock()
+				validatorVotes[proposalID] = vote
+				mutex.Unlock()
+			}(validator.Address, proposal.ID)
+		}
+	}
+
+	wg.Wait()
+
+	return votes
+}
@@ -0,0 +1,366 @@
+package fetchers
+
+import (
+	"context"
+	"errors"
+	"main/assets"
+	"main/pkg/config"
+	"main/pkg/constants"
+	"main/pkg/logger"
+	"main/pkg/tendermint"
+	"main/pkg/tracing"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGovernanceFetcherBase(t *testing.T) {
+	t.Parallel()
+
+	chains := []*config.Chain{{Name: "chain", LCDEndpoint: "example"}}
+	rpcs := map[string]*tendermint.RPCWithConsumers{
+		"chain": tendermint.RPCWithConsumersFromChain(
+			chains[0],
+			10,
+			*logger.GetNopLogger(),
+			tracing.InitNoopTracer(),
+		),
+	}
+	fetcher := NewGovernanceFetcher(
+		logger.GetNopLogger(),
+		chains,
+		rpcs,
+		tracing.InitNoopTracer(),
+	)
+
+	assert.NotNil(t, fetcher)
+	assert.Equal(t, constants.FetcherNameGovernance, fetcher.Name())
+	assert.Empty(t, fetcher.Dependencies())
+}
+
+func TestGovernanceFetcherQueryDisabled(t *testing.T) {
+	t.Parallel()
+
+	chains := []*config.Chain{{
+		Name:             "chain",
+		LCDEndpoint:      "example",
+		BechWalletPrefix: "cosmos",
+		Validators:       []config.Validator{{Address: "cosmosvaloper1xqz9pemz5e5zycaa89kys5aw6m8rhgsvw4328e"}},
+		Queries:          map[string]bool{"proposals": false},
+	}}
+	rpcs := map[string]*tendermint.RPCWithConsumers{
+		"chain": tendermint.RPCWithConsumersFromChain(
+			chains[0],
+			10,
+			*logger.GetNopLogger(),
+			tracing.InitNoopTracer(),
+		),
+	}
+	fetcher := &GovernanceFetcher{
+		Logger: *logger.GetNopLogger(),
+		Chains: chains,
+		RPCs:   rpcs,
+		Tracer: tracing.InitNoopTracer(),
+	}
+	governanceData, queries := fetcher.Fetch(context.Background())
+	assert.Empty(t, queries)
+
+	assert.Empty(t, governanceData.Proposals)
+	assert.Empty(t, governanceData.Votes)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestGovernanceFetcherQueryError(t *testing.T) {
+	httpmock.Activate()
+
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://api.cosmos.quokkastake.io/cosmos/gov/v1/proposals?proposal_status=PROPOSAL_STATUS_VOTING_PERIOD&pagination.limit=1000",
+		httpmock.NewErrorResponder(errors.New("error")),
+	)
+
+	chains := []*config.Chain{{
+		Name:             "chain",
+		LCDEndpoint:      "https://api.cosmos.quokkastake.io",
+		BechWalletPrefix: "cosmos",
+		Validators:       []config.Validator{{Address: "cosmosvaloper1xqz9pemz5e5zycaa89kys5aw6m8rhgsvw4328e"}},
+	}}
+	rpcs := map[string]*tendermint.RPCWithConsumers{
+		"chain": tendermint.RPCWithConsumersFromChain(
+			chains[0],
+			10,
+			*logger.GetNopLogger(),
+			tracing.InitNoopTracer(),
+		),
+	}
+	fetcher := &GovernanceFetcher{
+		Logger: *logger.GetNopLogger(),
+		Chains: chains,
+		RPCs:   rpcs,
+		Tracer: tracing.InitNoopTracer(),
+	}
+	governanceData, queries := fetcher.Fetch(context.Background())
+	assert.Len(t, queries, 1)
+	assert.False(t, queries[0].Success)
+
+	assert.Empty(t, governanceData.Proposals)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestGovernanceFetcherQuerySuccess(t *testing.T) {
+	httpmock.Activate()
+
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://api.cosmos.quokkastake.io/cosmos/gov/v1/proposals?proposal_status=PROPOSAL_STATUS_VOTING_PERIOD&pagination.limit=1000",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("gov-proposals.json")),
+	)
+	httpmock.RegisterResponder(
+		"GET",
+		"https://api.cosmos.quokkastake.io/cosmos/gov/v1/proposals/1000/tally",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("gov-tally.json")),
+	)
+	httpmock.RegisterResponder(
+		"GET",
+		"https://api.cosmos.quokkastake.io/cosmos/gov/v1/proposals/1000/votes/cosmos1xqz9pemz5e5zycaa89kys5aw6m8rhgsvtp9lt2",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("gov-vote.json")),
+	)
+
+	chains := []*config.Chain{{
+		Name:             "chain",
+		LCDEndpoint:      "https://api.cosmos.quokkastake.io",
+		BechWalletPrefix: "cosmos",
+		Validators:       []config.Validator{{Address: "cosmosvaloper1xqz9pemz5e5zycaa89kys5aw6m8rhgsvw4328e"}},
+	}}
+	rpcs := map[string]*tendermint.RPCWithConsumers{
+		"chain": tendermint.RPCWithConsumersFromChain(
+			chains[0],
+			10,
+			*logger.GetNopLogger(),
+			tracing.InitNoopTracer(),
+		),
+	}
+	fetcher := &GovernanceFetcher{
+		Logger: *logger.GetNopLogger(),
+		Chains: chains,
+		RPCs:   rpcs,
+		Tracer: tracing.InitNoopTracer(),
+	}
+	governanceData, queries := fetcher.Fetch(context.Background())
+	assert.Len(t, queries, 3)
+
+	for _, query := range queries {
+		assert.True(t, query.Success)
+	}
+
+	proposals, ok := governanceData.Proposals["chain"]
+	assert.True(t, ok)
+	require.Len(t, proposals, 1)
+	assert.Equal(t, "1000", proposals[0].ID)
+	assert.Equal(t, "Test proposal", proposals[0].Title)
+	assert.Equal(t, int64(1792843200), proposals[0].VotingEndTime.Unix())
+	require.NotNil(t, proposals[0].Tally)
+	assert.InDelta(t, 600, proposals[0].Tally.Yes, 0.01)
+	assert.InDelta(t, 1000, proposals[0].Tally.Total(), 0.01)
+
+	vote, ok := governanceData.Votes["chain"]["cosmosvaloper1xqz9pemz5e5zycaa89kys5aw6m8rhgsvw4328e"]["1000"]
+	assert.True(t, ok)
+	require.NotNil(t, vote)
+	require.Len(t, vote.Options, 2)
+	assert.Equal(t, "yes", vote.Options[0].GetOption())
+	assert.InDelta(t, 0.7, vote.Options[0].Weight.MustFloat64(), 0.01)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestGovernanceFetcherV1beta1Fallback(t *testing.T) {
+	httpmock.Activate()
+
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://api.cosmos.quokkastake.io/cosmos/gov/v1/proposals?proposal_status=PROPOSAL_STATUS_VOTING_PERIOD&pagination.limit=1000",
+		httpmock.NewBytesResponder(501, assets.GetBytesOrPanic("error.json")),
+	)
+	httpmock.RegisterResponder(
+		"GET",
+		"https://api.cosmos.quokkastake.io/cosmos/gov/v1beta1/proposals?proposal_status=PROPOSAL_STATUS_VOTING_PERIOD&pagination.limit=1000",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("gov-proposals-v1beta1.json")),
+	)
+	httpmock.RegisterResponder(
+		"GET",
+		"https://api.cosmos.quokkastake.io/cosmos/gov/v1beta1/proposals/1000/tally",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("gov-tally-v1beta1.json")),
+	)
+	httpmock.RegisterResponder(
+		"GET",
+		"https://api.cosmos.quokkastake.io/cosmos/gov/v1beta1/proposals/1000/votes/cosmos1xqz9pemz5e5zycaa89kys5aw6m8rhgsvtp9lt2",
+		httpmock.NewBytesResponder(400, assets.GetBytesOrPanic("gov-vote-not-found.json")),
+	)
+
+	chains := []*config.Chain{{
+		Name:             "chain",
+		LCDEndpoint:      "https://api.cosmos.quokkastake.io",
+		BechWalletPrefix: "cosmos",
+		Validators:       []config.Validator{{Address: "cosmosvaloper1xqz9pemz5e5zycaa89kys5aw6m8rhgsvw4328e"}},
+	}}
+	rpcs := map[string]*tendermint.RPCWithConsumers{
+		"chain": tendermint.RPCWithConsumersFromChain(
+			chains[0],
+			10,
+			*logger.GetNopLogger(),
+			tracing.InitNoopTracer(),
+		),
+	}
+	fetcher := &GovernanceFetcher{
+		Logger: *logger.GetNopLogger(),
+		Chains: chains,
+		RPCs:   rpcs,
+		Tracer: tracing.InitNoopTracer(),
+	}
+	governanceData, queries := fetcher.Fetch(context.Background())
+	assert.Len(t, queries, 4)
+	assert.False(t, queries[0].Success)
+
+	for _, query := range queries[1:] {
+		assert.True(t, query.Success)
+	}
+
+	proposals, ok := governanceData.Proposals["chain"]
+	assert.True(t, ok)
+	require.Len(t, proposals, 1)
+	assert.Equal(t, "1000", proposals[0].ID)
+	assert.Equal(t, "Test proposal", proposals[0].Title)
+	require.NotNil(t, proposals[0].Tally)
+	assert.InDelta(t, 200, proposals[0].Tally.No, 0.01)
+
+	vote, ok := governanceData.Votes["chain"]["cosmosvaloper1xqz9pemz5e5zycaa89kys5aw6m8rhgsvw4328e"]["1000"]
+	assert.True(t, ok)
+	assert.Nil(t, vote)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestGovernanceFetcherVoteQueryError(t *testing.T) {
+	httpmock.Activate()
+
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://api.cosmos.quokkastake.io/cosmos/gov/v1/proposals?proposal_status=PROPOSAL_STATUS_VOTING_PERIOD&pagination.limit=1000",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("gov-proposals.json")),
+	)
+	httpmock.RegisterResponder(
+		"GET",
+		"https://api.cosmos.quokkastake.io/cosmos/gov/v1/proposals/1000/tally",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("gov-tally.json")),
+	)
+	httpmock.RegisterResponder(
+		"GET",
+		"https://api.cosmos.quokkastake.io/cosmos/gov/v1/proposals/1000/votes/cosmos1xqz9pemz5e5zycaa89kys5aw6m8rhgsvtp9lt2",
+		httpmock.NewBytesResponder(501, assets.GetBytesOrPanic("error.json")),
+	)
+
+	chains := []*config.Chain{{
+		Name:             "chain",
+		LCDEndpoint:      "https://api.cosmos.quokkastake.io",
+		BechWalletPrefix: "cosmos",
+		Validators:       []config.Validator{{Address: "cosmosvaloper1xqz9pemz5e5zycaa89kys5aw6m8rhgsvw4328e"}},
+	}}
+	rpcs := map[string]*tendermint.RPCWithConsumers{
+		"chain": tendermint.RPCWithConsumersFromChain(
+			chains[0],
+			10,
+			*logger.GetNopLogger(),
+			tracing.InitNoopTracer(),
+		),
+	}
+	fetcher := &GovernanceFetcher{
+		Logger: *logger.GetNopLogger(),
+		Chains: chains,
+		RPCs:   rpcs,
+		Tracer: tracing.InitNoopTracer(),
+	}
+	governanceData, queries := fetcher.Fetch(context.Background())
+	assert.Len(t, queries, 3)
+
+	failedQueries := 0
+	for _, query := range queries {
+		if !query.Success {
+			failedQueries++
+		}
+	}
+	assert.Equal(t, 1, failedQueries)
+
+	_, ok := governanceData.Votes["chain"]["cosmosvaloper1xqz9pemz5e5zycaa89kys5aw6m8rhgsvw4328e"]["1000"]
+	assert.False(t, ok)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestGovernanceFetcherConsumer(t *testing.T) {
+	httpmock.Activate()
+
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://api.neutron.quokkastake.io/cosmos/gov/v1/proposals?proposal_status=PROPOSAL_STATUS_VOTING_PERIOD&pagination.limit=1000",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("gov-proposals.json")),
+	)
+	httpmock.RegisterResponder(
+		"GET",
+		"https://api.neutron.quokkastake.io/cosmos/gov/v1/proposals/1000/tally",
+		httpmock.NewErrorResponder(errors.New("error")),
+	)
+	httpmock.RegisterResponder(
+		"GET",
+		"https://api.neutron.quokkastake.io/cosmos/gov/v1/proposals/1000/votes/neutron1xqz9pemz5e5zycaa89kys5aw6m8rhgsv07va3d",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("gov-vote.json")),
+	)
+
+	chains := []*config.Chain{{
+		Name:             "chain",
+		LCDEndpoint:      "https://api.cosmos.quokkastake.io",
+		BechWalletPrefix: "cosmos",
+		Validators:       []config.Validator{{Address: "cosmosvaloper1xqz9pemz5e5zycaa89kys5aw6m8rhgsvw4328e"}},
+		Queries:          map[string]bool{"proposals": false},
+		ConsumerChains: []*config.ConsumerChain{
+			{
+				Name:             "consumer",
+				LCDEndpoint:      "https://api.neutron.quokkastake.io",
+				BechWalletPrefix: "neutron",
+			},
+		},
+	}}
+	rpcs := map[string]*tendermint.RPCWithConsumers{
+		"chain": tendermint.RPCWithConsumersFromChain(
+			chains[0],
+			10,
+			*logger.GetNopLogger(),
+			tracing.InitNoopTracer(),
+		),
+	}
+	fetcher := &GovernanceFetcher{
+		Logger: *logger.GetNopLogger(),
+		Chains: chains,
+		RPCs:   rpcs,
+		Tracer: tracing.InitNoopTracer(),
+	}
+	governanceData, queries := fetcher.Fetch(context.Background())
+	assert.Len(t, queries, 3)
+
+	proposals, ok := governanceData.Proposals["consumer"]
+	assert.True(t, ok)
+	require.Len(t, proposals, 1)
+	assert.Nil(t, proposals[0].Tally)
+
+	vote, ok := governanceData.Votes["consumer"]["cosmosvaloper1xqz9pemz5e5zycaa89kys5aw6m8rhgsvw4328e"]["1000"]
+	assert.True(t, ok)
+	assert.NotNil(t, vote)
+}
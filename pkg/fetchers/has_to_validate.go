@@ -4,6 +4,7 @@ import (
 	"context"
 	"main/pkg/config"
 	"main/pkg/constants"
+	statePkg "main/pkg/state"
 	"main/pkg/tendermint"
 	"main/pkg/types"
 	"sync"
@@ -17,18 +18,14 @@ type ValidatorConsumersFetcher struct {
 	Chains []*config.Chain
 	RPCs   map[string]*tendermint.RPCWithConsumers
 	Tracer trace.Tracer
-
-	wg    sync.WaitGroup
-	mutex sync.Mutex
-
-	queryInfos             []*types.QueryInfo
-	allValidatorsConsumers map[string]map[string]map[string]bool
 }
 
 type ValidatorConsumersData struct {
 	Infos map[string]map[string]map[string]bool
 }
 
+var ValidatorConsumersKey = statePkg.NewKey[ValidatorConsumersData](constants.FetcherNameValidatorConsumers)
+
 func NewValidatorConsumersFetcher(
 	logger *zerolog.Logger,
 	chains []*config.Chain,
@@ -50,76 +47,79 @@ func (q *ValidatorConsumersFetcher) Dependencies() []constants.FetcherName {
 func (q *ValidatorConsumersFetcher) Fetch(
 	ctx context.Context,
 	data ...any,
-) (any, []*types.QueryInfo) {
-	q.queryInfos = []*types.QueryInfo{}
-	q.allValidatorsConsumers = map[string]map[string]map[string]bool{}
-
-	for _, chain := range q.Chains {
-		if !chain.IsProvider.Bool {
-			continue
+) (ValidatorConsumersData, []*types.QueryInfo) {
+	queryInfos := []*types.QueryInfo{}
+	allValidatorsConsumers := map[string]map[string]map[string]bool{}
+
+	var (
+		wg    sync.WaitGroup
+		mutex sync.Mutex
+	)
+
+	processChain := func(
+		chainName string,
+		rpc *tendermint.RPC,
+		validator config.Validator,
+	) {
+		defer wg.Done()
+
+		if validator.ConsensusAddress == "" {
+			return
 		}
 
-		q.allValidatorsConsumers[chain.Name] = map[string]map[string]bool{}
-
-		rpc := q.RPCs[chain.Name]
+		validatorConsumers, query, err := rpc.GetValidatorConsumerChains(ctx, validator.ConsensusAddress)
 
-		q.wg.Add(len(chain.Validators))
+		mutex.Lock()
+		defer mutex.Unlock()
 
-		for _, validator := range chain.Validators {
-			go q.processChain(
-				ctx,
-				chain.Name,
-				rpc.RPC,
-				validator,
-			)
+		if query != nil {
+			queryInfos = append(queryInfos, query)
 		}
-	}
 
-	q.wg.Wait()
+		if err != nil {
+			q.Logger.Error().
+				Err(err).
+				Str("chain", chainName).
+				Msg("Error querying node info")
 
-	return ValidatorConsumersData{Infos: q.allValidatorsConsumers}, q.queryInfos
-}
+			return
+		}
 
-func (q *ValidatorConsumersFetcher) Name() constants.FetcherName {
-	return constants.FetcherNameValidatorConsumers
-}
+		if validatorConsumers == nil {
+			return
+		}
 
-func (q *ValidatorConsumersFetcher) processChain(
-	ctx context.Context,
-	chainName string,
-	rpc *tendermint.RPC,
-	validator config.Validator,
-) {
-	defer q.wg.Done()
-
-	if validator.ConsensusAddress == "" {
-		return
+		allValidatorsConsumers[chainName][validator.Address] = map[string]bool{}
+		for _, consumerID := range validatorConsumers.ConsumerIds {
+			allValidatorsConsumers[chainName][validator.Address][consumerID] = true
+		}
 	}
 
-	validatorConsumers, query, err := rpc.GetValidatorConsumerChains(ctx, validator.ConsensusAddress)
+	for _, chain := range q.Chains {
+		if chain.IsProvider.Bool {
+			allValidatorsConsumers[chain.Name] = map[string]map[string]bool{}
+		}
+	}
 
-	q.mutex.Lock()
-	defer q.mutex.Unlock()
+	for _, chain := range q.Chains {
+		if !chain.IsProvider.Bool {
+			continue
+		}
 
-	if query != nil {
-		q.queryInfos = append(q.queryInfos, query)
-	}
+		rpc := q.RPCs[chain.Name]
 
-	if err != nil {
-		q.Logger.Error().
-			Err(err).
-			Str("chain", chainName).
-			Msg("Error querying node info")
+		wg.Add(len(chain.Validators))
 
-		return
+		for _, validator := range chain.Validators {
+			go processChain(chain.Name, rpc.RPC, validator)
+		}
 	}
 
-	if validatorConsumers == nil {
-		return
-	}
+	wg.Wait()
 
-	q.allValidatorsConsumers[chainName][validator.Address] = map[string]bool{}
-	for _, consumerID := range validatorConsumers.ConsumerIds {
-		q.allValidatorsConsumers[chainName][validator.Address][consumerID] = true
-	}
+	return ValidatorConsumersData{Infos: allValidatorsConsumers}, queryInfos
+}
+
+func (q *ValidatorConsumersFetcher) Name() constants.FetcherName {
+	return constants.FetcherNameValidatorConsumers
 }
@@ -77,11 +77,9 @@ func TestHasToValidateFetcherNotProvider(t *testing.T) {
 		RPCs:   rpcs,
 		Tracer: tracing.InitNoopTracer(),
 	}
-	data, queries := fetcher.Fetch(context.Background())
+	validatorsData, queries := fetcher.Fetch(context.Background())
 	assert.Empty(t, queries)
 
-	validatorsData, ok := data.(ValidatorConsumersData)
-	assert.True(t, ok)
 	assert.Empty(t, validatorsData.Infos)
 }
 
@@ -111,12 +109,9 @@ func TestHasToValidateFetcherNoConsensusKey(t *testing.T) {
 		RPCs:   rpcs,
 		Tracer: tracing.InitNoopTracer(),
 	}
-	data, queries := fetcher.Fetch(context.Background())
+	validatorsData, queries := fetcher.Fetch(context.Background())
 	assert.Empty(t, queries)
 
-	validatorsData, ok := data.(ValidatorConsumersData)
-	assert.True(t, ok)
-
 	chainData, ok := validatorsData.Infos["chain"]
 	assert.True(t, ok)
 	assert.Empty(t, chainData)
@@ -150,12 +145,9 @@ func TestHasToValidateFetcherQueryDisabled(t *testing.T) {
 		RPCs:   rpcs,
 		Tracer: tracing.InitNoopTracer(),
 	}
-	data, queries := fetcher.Fetch(context.Background())
+	validatorsData, queries := fetcher.Fetch(context.Background())
 	assert.Empty(t, queries)
 
-	validatorsData, ok := data.(ValidatorConsumersData)
-	assert.True(t, ok)
-
 	chainData, ok := validatorsData.Infos["chain"]
 	assert.True(t, ok)
 	assert.Empty(t, chainData)
@@ -197,13 +189,10 @@ func TestHasToValidateFetcherQueryError(t *testing.T) {
 		RPCs:   rpcs,
 		Tracer: tracing.InitNoopTracer(),
 	}
-	data, queries := fetcher.Fetch(context.Background())
+	validatorsData, queries := fetcher.Fetch(context.Background())
 	assert.Len(t, queries, 1)
 	assert.False(t, queries[0].Success)
 
-	validatorsData, ok := data.(ValidatorConsumersData)
-	assert.True(t, ok)
-
 	chainData, ok := validatorsData.Infos["chain"]
 	assert.True(t, ok)
 	assert.Empty(t, chainData)
@@ -245,13 +234,10 @@ func TestHasToValidateFetcherNodeError(t *testing.T) {
 		RPCs:   rpcs,
 		Tracer: tracing.InitNoopTracer(),
 	}
-	data, queries := fetcher.Fetch(context.Background())
+	validatorsData, queries := fetcher.Fetch(context.Background())
 	assert.Len(t, queries, 1)
 	assert.False(t, queries[0].Success)
 
-	validatorsData, ok := data.(ValidatorConsumersData)
-	assert.True(t, ok)
-
 	chainData, ok := validatorsData.Infos["chain"]
 	assert.True(t, ok)
 	assert.Empty(t, chainData)
@@ -293,13 +279,10 @@ func TestHasToValidateFetcherQuerySuccess(t *testing.T) {
 		RPCs:   rpcs,
 		Tracer: tracing.InitNoopTracer(),
 	}
-	data, queries := fetcher.Fetch(context.Background())
+	validatorsData, queries := fetcher.Fetch(context.Background())
 	assert.Len(t, queries, 1)
 	assert.True(t, queries[0].Success)
 
-	validatorsData, ok := data.(ValidatorConsumersData)
-	assert.True(t, ok)
-
 	chainData, ok := validatorsData.Infos["chain"]
 	assert.True(t, ok)
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"main/pkg/config"
 	"main/pkg/constants"
+	statePkg "main/pkg/state"
 	"main/pkg/tendermint"
 	"main/pkg/types"
 	"sync"
@@ -25,6 +26,8 @@ type InflationData struct {
 	Inflation map[string]math.LegacyDec
 }
 
+var InflationKey = statePkg.NewKey[InflationData](constants.FetcherNameInflation)
+
 func NewInflationFetcher(
 	logger *zerolog.Logger,
 	chains []*config.Chain,
@@ -45,7 +48,7 @@ func (q *InflationFetcher) Dependencies() []constants.FetcherName {
 func (q *InflationFetcher) Fetch(
 	ctx context.Context,
 	data ...any,
-) (any, []*types.QueryInfo) {
+) (InflationData, []*types.QueryInfo) {
 	var queryInfos []*types.QueryInfo
 
 	allInflation := map[string]math.LegacyDec{}
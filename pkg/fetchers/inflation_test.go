@@ -72,11 +72,9 @@ func TestInflationFetcherQueryDisabled(t *testing.T) {
 		RPCs:   rpcs,
 		Tracer: tracing.InitNoopTracer(),
 	}
-	data, queries := fetcher.Fetch(context.Background())
+	paramsData, queries := fetcher.Fetch(context.Background())
 	assert.Empty(t, queries)
 
-	paramsData, ok := data.(InflationData)
-	assert.True(t, ok)
 	assert.Empty(t, paramsData.Inflation)
 }
 
@@ -111,12 +109,10 @@ func TestInflationFetcherQueryError(t *testing.T) {
 		RPCs:   rpcs,
 		Tracer: tracing.InitNoopTracer(),
 	}
-	data, queries := fetcher.Fetch(context.Background())
+	paramsData, queries := fetcher.Fetch(context.Background())
 	assert.Len(t, queries, 1)
 	assert.False(t, queries[0].Success)
 
-	paramsData, ok := data.(InflationData)
-	assert.True(t, ok)
 	assert.Empty(t, paramsData.Inflation)
 }
 
@@ -151,12 +147,10 @@ func TestInflationFetcherNodeError(t *testing.T) {
 		RPCs:   rpcs,
 		Tracer: tracing.InitNoopTracer(),
 	}
-	data, queries := fetcher.Fetch(context.Background())
+	paramsData, queries := fetcher.Fetch(context.Background())
 	assert.Len(t, queries, 1)
 	assert.False(t, queries[0].Success)
 
-	paramsData, ok := data.(InflationData)
-	assert.True(t, ok)
 	assert.Empty(t, paramsData.Inflation)
 }
 
@@ -194,13 +188,10 @@ func TestInflationFetcherQuerySuccess(t *testing.T) {
 		RPCs:   rpcs,
 		Tracer: tracing.InitNoopTracer(),
 	}
-	data, queries := fetcher.Fetch(context.Background())
+	paramsData, queries := fetcher.Fetch(context.Background())
 	assert.Len(t, queries, 1)
 	assert.True(t, queries[0].Success)
 
-	paramsData, ok := data.(InflationData)
-	assert.True(t, ok)
-
 	chainData, ok := paramsData.Inflation["chain"]
 	assert.True(t, ok)
 	assert.NotNil(t, chainData)
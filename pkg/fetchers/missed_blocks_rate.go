@@ -0,0 +1,111 @@
+package fetchers
+
+import (
+	"context"
+	"main/pkg/constants"
+	statePkg "main/pkg/state"
+	"main/pkg/types"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+type MissedBlocksRateFetcher struct {
+	Logger zerolog.Logger
+
+	// chain -> valoper -> the signing info seen on the previous fetch
+	samples map[string]map[string]missedBlocksSample
+	mutex   sync.Mutex
+}
+
+type missedBlocksSample struct {
+	SigningInfo *types.SigningInfoResponse
+	Counter     int64
+	Time        time.Time
+	Rate        float64
+	HasRate     bool
+}
+
+type MissedBlocksRateData struct {
+	// chain -> valoper -> missed blocks counter growth per second
+	Rates map[string]map[string]float64
+}
+
+var MissedBlocksRateKey = statePkg.NewKey[MissedBlocksRateData](constants.FetcherNameMissedBlocksRate)
+
+func NewMissedBlocksRateFetcher(logger *zerolog.Logger) *MissedBlocksRateFetcher {
+	return &MissedBlocksRateFetcher{
+		Logger:  logger.With().Str("component", "missed_blocks_rate_fetcher").Logger(),
+		samples: map[string]map[string]missedBlocksSample{},
+	}
+}
+
+func (q *MissedBlocksRateFetcher) Dependencies() []constants.FetcherName {
+	return []constants.FetcherName{constants.FetcherNameSigningInfo}
+}
+
+func (q *MissedBlocksRateFetcher) Fetch(
+	ctx context.Context,
+	data ...any,
+) (MissedBlocksRateData, []*types.QueryInfo) {
+	allRates := map[string]map[string]float64{}
+
+	signingInfos, err := statePkg.Convert[SigningInfoData](data[0])
+	if err != nil {
+		q.Logger.Error().Err(err).Msg("Error converting signing infos")
+		return MissedBlocksRateData{Rates: allRates}, []*types.QueryInfo{}
+	}
+
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	now := time.Now()
+	samples := map[string]map[string]missedBlocksSample{}
+
+	for chainName, validators := range signingInfos.SigningInfos {
+		allRates[chainName] = map[string]float64{}
+		samples[chainName] = map[string]missedBlocksSample{}
+
+		for valoper, signingInfo := range validators {
+			if signingInfo == nil || signingInfo.ValSigningInfo.MissedBlocksCounter.IsNil() {
+				continue
+			}
+
+			previous, found := q.samples[chainName][valoper]
+
+			// The signing info was served from cache, so the counter was not measured again.
+			if found && previous.SigningInfo == signingInfo {
+				samples[chainName][valoper] = previous
+				if previous.HasRate {
+					allRates[chainName][valoper] = previous.Rate
+				}
+
+				continue
+			}
+
+			sample := missedBlocksSample{
+				SigningInfo: signingInfo,
+				Counter:     signingInfo.ValSigningInfo.MissedBlocksCounter.Int64(),
+				Time:        now,
+			}
+
+			if elapsed := now.Sub(previous.Time); found && elapsed > 0 {
+				// The counter can go down as the window slides.
+				sample.Rate = float64(max(sample.Counter-previous.Counter, 0)) / elapsed.Seconds()
+				sample.HasRate = true
+				allRates[chainName][valoper] = sample.Rate
+			}
+
+			samples[chainName][valoper] = sample
+		}
+	}
+
+	q.samples = samples
+
+	return MissedBlocksRateData{Rates: allRates}, []*types.QueryInfo{}
+}
+
+func (q *MissedBlocksRateFetcher) Name() constants.FetcherName {
+	return constants.FetcherNameMissedBlocksRate
+}
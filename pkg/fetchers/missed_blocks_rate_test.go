@@ -0,0 +1,110 @@
+package fetchers
+
+import (
+	"context"
+	"main/pkg/constants"
+	"main/pkg/logger"
+	"main/pkg/types"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMissedBlocksRateFetcherBase(t *testing.T) {
+	t.Parallel()
+
+	fetcher := NewMissedBlocksRateFetcher(logger.GetNopLogger())
+
+	assert.NotNil(t, fetcher)
+	assert.Equal(t, constants.FetcherNameMissedBlocksRate, fetcher.Name())
+	assert.Equal(t, []constants.FetcherName{constants.FetcherNameSigningInfo}, fetcher.Dependencies())
+}
+
+func TestMissedBlocksRateFetcherInvalidData(t *testing.T) {
+	t.Parallel()
+
+	fetcher := NewMissedBlocksRateFetcher(logger.GetNopLogger())
+	ratesData, queries := fetcher.Fetch(context.Background(), 3)
+	assert.Empty(t, queries)
+	assert.Empty(t, ratesData.Rates)
+}
+
+func TestMissedBlocksRateFetcherNoData(t *testing.T) {
+	t.Parallel()
+
+	fetcher := NewMissedBlocksRateFetcher(logger.GetNopLogger())
+	ratesData, queries := fetcher.Fetch(context.Background(), nil)
+	assert.Empty(t, queries)
+	assert.Empty(t, ratesData.Rates)
+}
+
+func TestMissedBlocksRateFetcherFirstFetch(t *testing.T) {
+	t.Parallel()
+
+	fetcher := NewMissedBlocksRateFetcher(logger.GetNopLogger())
+	ratesData, queries := fetcher.Fetch(context.Background(), SigningInfoData{
+		SigningInfos: map[string]map[string]*types.SigningInfoResponse{
+			"chain": {
+				"validator": {ValSigningInfo: types.SigningInfo{MissedBlocksCounter: math.NewInt(8)}},
+				"empty":     nil,
+			},
+		},
+	})
+	assert.Empty(t, queries)
+
+	// the rate needs the counter from the previous fetch
+	chainRates, ok := ratesData.Rates["chain"]
+	assert.True(t, ok)
+	assert.Empty(t, chainRates)
+	assert.Len(t, fetcher.samples["chain"], 1)
+}
+
+func TestMissedBlocksRateFetcherRate(t *testing.T) {
+	t.Parallel()
+
+	fetcher := NewMissedBlocksRateFetcher(logger.GetNopLogger())
+	fetcher.samples = map[string]map[string]missedBlocksSample{
+		"chain": {
+			"validator":  {Counter: 2, Time: time.Now().Add(-time.Minute)},
+			"recovering": {Counter: 20, Time: time.Now().Add(-time.Minute)},
+		},
+	}
+
+	ratesData, queries := fetcher.Fetch(context.Background(), SigningInfoData{
+		SigningInfos: map[string]map[string]*types.SigningInfoResponse{
+			"chain": {
+				"validator":  {ValSigningInfo: types.SigningInfo{MissedBlocksCounter: math.NewInt(8)}},
+				"recovering": {ValSigningInfo: types.SigningInfo{MissedBlocksCounter: math.NewInt(10)}},
+			},
+		},
+	})
+	assert.Empty(t, queries)
+	assert.InDelta(t, 0.1, ratesData.Rates["chain"]["validator"], 0.001)
+	assert.Zero(t, ratesData.Rates["chain"]["recovering"])
+}
+
+func TestMissedBlocksRateFetcherCachedSigningInfo(t *testing.T) {
+	t.Parallel()
+
+	signingInfos := SigningInfoData{
+		SigningInfos: map[string]map[string]*types.SigningInfoResponse{
+			"chain": {
+				"validator": {ValSigningInfo: types.SigningInfo{MissedBlocksCounter: math.NewInt(8)}},
+			},
+		},
+	}
+
+	fetcher := NewMissedBlocksRateFetcher(logger.GetNopLogger())
+	fetcher.samples = map[string]map[string]missedBlocksSample{
+		"chain": {"validator": {Counter: 2, Time: time.Now().Add(-time.Minute)}},
+	}
+
+	ratesData, _ := fetcher.Fetch(context.Background(), signingInfos)
+	assert.InDelta(t, 0.1, ratesData.Rates["chain"]["validator"], 0.001)
+
+	// the same signing info was not measured again, so the previous rate is kept
+	ratesData, _ = fetcher.Fetch(context.Background(), signingInfos)
+	assert.InDelta(t, 0.1, ratesData.Rates["chain"]["validator"], 0.001)
+}
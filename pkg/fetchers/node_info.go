@@ -4,6 +4,7 @@ import (
 	"context"
 	"main/pkg/config"
 	"main/pkg/constants"
+	statePkg "main/pkg/state"
 	"main/pkg/tendermint"
 	"main/pkg/types"
 	"sync"
@@ -17,18 +18,14 @@ type NodeInfoFetcher struct {
 	Chains []*config.Chain
 	RPCs   map[string]*tendermint.RPCWithConsumers
 	Tracer trace.Tracer
-
-	wg    sync.WaitGroup
-	mutex sync.Mutex
-
-	queryInfos   []*types.QueryInfo
-	allNodeInfos map[string]*types.NodeInfoResponse
 }
 
 type NodeInfoData struct {
 	NodeInfos map[string]*types.NodeInfoResponse
 }
 
+var NodeInfoKey = statePkg.NewKey[NodeInfoData](constants.FetcherNameNodeInfo)
+
 func NewNodeInfoFetcher(
 	logger *zerolog.Logger,
 	chains []*config.Chain,
@@ -50,68 +47,60 @@ func (q *NodeInfoFetcher) Dependencies() []constants.FetcherName {
 func (q *NodeInfoFetcher) Fetch(
 	ctx context.Context,
 	data ...any,
-) (any, []*types.QueryInfo) {
-	q.queryInfos = []*types.QueryInfo{}
-	q.allNodeInfos = map[string]*types.NodeInfoResponse{}
+) (NodeInfoData, []*types.QueryInfo) {
+	queryInfos := []*types.QueryInfo{}
+	allNodeInfos := map[string]*types.NodeInfoResponse{}
 
-	for _, chain := range q.Chains {
-		rpc := q.RPCs[chain.Name]
+	var (
+		wg    sync.WaitGroup
+		mutex sync.Mutex
+	)
 
-		q.wg.Add(1 + len(chain.ConsumerChains))
+	processChain := func(chainName string, rpc *tendermint.RPC) {
+		defer wg.Done()
 
-		go q.processChain(
-			ctx,
-			chain.Name,
-			rpc.RPC,
-		)
+		nodeInfo, query, err := rpc.GetNodeInfo(ctx)
 
-		for consumerIndex, consumerChain := range chain.ConsumerChains {
-			consumerRPC := rpc.Consumers[consumerIndex]
-			go q.processChain(
-				ctx,
-				consumerChain.Name,
-				consumerRPC,
-			)
-		}
-	}
+		mutex.Lock()
+		defer mutex.Unlock()
 
-	q.wg.Wait()
+		if query != nil {
+			queryInfos = append(queryInfos, query)
+		}
 
-	return NodeInfoData{NodeInfos: q.allNodeInfos}, q.queryInfos
-}
+		if err != nil {
+			q.Logger.Error().
+				Err(err).
+				Str("chain", chainName).
+				Msg("Error querying node info")
 
-func (q *NodeInfoFetcher) Name() constants.FetcherName {
-	return constants.FetcherNameNodeInfo
-}
+			return
+		}
 
-func (q *NodeInfoFetcher) processChain(
-	ctx context.Context,
-	chainName string,
-	rpc *tendermint.RPC,
-) {
-	defer q.wg.Done()
+		if nodeInfo == nil {
+			return
+		}
 
-	nodeInfo, query, err := rpc.GetNodeInfo(ctx)
+		allNodeInfos[chainName] = nodeInfo
+	}
 
-	q.mutex.Lock()
-	defer q.mutex.Unlock()
+	for _, chain := range q.Chains {
+		rpc := q.RPCs[chain.Name]
 
-	if query != nil {
-		q.queryInfos = append(q.queryInfos, query)
-	}
+		wg.Add(1 + len(chain.ConsumerChains))
 
-	if err != nil {
-		q.Logger.Error().
-			Err(err).
-			Str("chain", chainName).
-			Msg("Error querying node info")
+		go processChain(chain.Name, rpc.RPC)
 
-		return
+		for consumerIndex, consumerChain := range chain.ConsumerChains {
+			go processChain(consumerChain.Name, rpc.Consumers[consumerIndex])
+		}
 	}
 
-	if nodeInfo == nil {
-		return
-	}
+	wg.Wait()
+
+	return NodeInfoData{NodeInfos: allNodeInfos}, queryInfos
+}
 
-	q.allNodeInfos[chainName] = nodeInfo
+func (q *NodeInfoFetcher) Name() constants.FetcherName {
+	return constants.FetcherNameNodeInfo
 }
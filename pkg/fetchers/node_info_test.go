@@ -72,11 +72,9 @@ func TestNodeInfoFetcherQueryDisabled(t *testing.T) {
 		RPCs:   rpcs,
 		Tracer: tracing.InitNoopTracer(),
 	}
-	data, queries := fetcher.Fetch(context.Background())
+	chainData, queries := fetcher.Fetch(context.Background())
 	assert.Empty(t, queries)
 
-	chainData, ok := data.(NodeInfoData)
-	assert.True(t, ok)
 	assert.Empty(t, chainData.NodeInfos)
 }
 
@@ -112,12 +110,10 @@ func TestNodeInfoFetcherQueryError(t *testing.T) {
 		RPCs:   rpcs,
 		Tracer: tracing.InitNoopTracer(),
 	}
-	data, queries := fetcher.Fetch(context.Background())
+	chainData, queries := fetcher.Fetch(context.Background())
 	assert.Len(t, queries, 1)
 	assert.False(t, queries[0].Success)
 
-	chainData, ok := data.(NodeInfoData)
-	assert.True(t, ok)
 	assert.Empty(t, chainData.NodeInfos)
 }
 
@@ -153,12 +149,10 @@ func TestNodeInfoFetcherNodeError(t *testing.T) {
 		RPCs:   rpcs,
 		Tracer: tracing.InitNoopTracer(),
 	}
-	data, queries := fetcher.Fetch(context.Background())
+	chainData, queries := fetcher.Fetch(context.Background())
 	assert.Len(t, queries, 1)
 	assert.False(t, queries[0].Success)
 
-	chainData, ok := data.(NodeInfoData)
-	assert.True(t, ok)
 	assert.Empty(t, chainData.NodeInfos)
 }
 
@@ -194,13 +188,10 @@ func TestNodeInfoFetcherQuerySuccess(t *testing.T) {
 		RPCs:   rpcs,
 		Tracer: tracing.InitNoopTracer(),
 	}
-	data, queries := fetcher.Fetch(context.Background())
+	nodeInfoData, queries := fetcher.Fetch(context.Background())
 	assert.Len(t, queries, 1)
 	assert.True(t, queries[0].Success)
 
-	nodeInfoData, ok := data.(NodeInfoData)
-	assert.True(t, ok)
-
 	chainData, ok := nodeInfoData.NodeInfos["chain"]
 	assert.True(t, ok)
 	assert.Equal(t, "0.37.6", chainData.DefaultNodeInfo.Version)
@@ -246,13 +237,10 @@ func TestNodeInfoFetcherConsumer(t *testing.T) {
 		RPCs:   rpcs,
 		Tracer: tracing.InitNoopTracer(),
 	}
-	data, queries := fetcher.Fetch(context.Background())
+	nodeInfoData, queries := fetcher.Fetch(context.Background())
 	assert.Len(t, queries, 1)
 	assert.True(t, queries[0].Success)
 
-	nodeInfoData, ok := data.(NodeInfoData)
-	assert.True(t, ok)
-
 	chainData, ok := nodeInfoData.NodeInfos["consumer"]
 	assert.True(t, ok)
 	assert.Equal(t, "0.37.6", chainData.DefaultNodeInfo.Version)
@@ -6,6 +6,7 @@ import (
 	"main/pkg/constants"
 	"main/pkg/price_fetchers"
 	coingeckoPkg "main/pkg/price_fetchers/coingecko"
+	statePkg "main/pkg/state"
 	"main/pkg/types"
 	"sync"
 
@@ -31,6 +32,8 @@ type PriceData struct {
 	Prices map[string]map[string]PriceInfo
 }
 
+var PriceKey = statePkg.NewKey[PriceData](constants.FetcherNamePrice)
+
 func NewPriceFetcher(
 	logger *zerolog.Logger,
 	config *config.Config,
@@ -55,7 +58,7 @@ func (q *PriceFetcher) Dependencies() []constants.FetcherName {
 func (q *PriceFetcher) Fetch(
 	ctx context.Context,
 	data ...any,
-) (any, []*types.QueryInfo) {
+) (PriceData, []*types.QueryInfo) {
 	queries := []*types.QueryInfo{}
 	denomsByPriceFetcher := map[constants.PriceFetcherName][]price_fetchers.ChainWithDenom{}
 
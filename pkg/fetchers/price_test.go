@@ -60,12 +60,10 @@ func TestPriceFetcherProviderCoingeckoError(t *testing.T) {
 		config,
 		tracer,
 	)
-	data, queries := fetcher.Fetch(context.Background())
+	balanceData, queries := fetcher.Fetch(context.Background())
 	assert.Len(t, queries, 1)
 	assert.False(t, queries[0].Success)
 
-	balanceData, ok := data.(PriceData)
-	assert.True(t, ok)
 	assert.Empty(t, balanceData.Prices)
 }
 
@@ -94,13 +92,10 @@ func TestPriceFetcherProviderCoingeckoSuccess(t *testing.T) {
 		config,
 		tracer,
 	)
-	data, queries := fetcher.Fetch(context.Background())
+	balanceData, queries := fetcher.Fetch(context.Background())
 	assert.Len(t, queries, 1)
 	assert.True(t, queries[0].Success)
 
-	balanceData, ok := data.(PriceData)
-	assert.True(t, ok)
-
 	chainData, ok := balanceData.Prices["chain"]
 	assert.True(t, ok)
 
@@ -143,13 +138,10 @@ func TestPriceFetcherConsumerCoingeckoSuccess(t *testing.T) {
 		config,
 		tracer,
 	)
-	data, queries := fetcher.Fetch(context.Background())
+	balanceData, queries := fetcher.Fetch(context.Background())
 	assert.Len(t, queries, 1)
 	assert.True(t, queries[0].Success)
 
-	balanceData, ok := data.(PriceData)
-	assert.True(t, ok)
-
 	chainData, ok := balanceData.Prices["consumer"]
 	assert.True(t, ok)
 
@@ -0,0 +1,98 @@
+package fetchers
+
+import (
+	"context"
+	"main/pkg/config"
+	"main/pkg/constants"
+	statePkg "main/pkg/state"
+	"main/pkg/tendermint"
+	"main/pkg/types"
+	"sync"
+
+	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type ProposersFetcher struct {
+	Logger zerolog.Logger
+	Chains []*config.Chain
+	RPCs   map[string]*tendermint.RPCWithConsumers
+	Tracer trace.Tracer
+}
+
+type ProposersData struct {
+	Proposers map[string]*types.BlockProposers
+}
+
+var ProposersKey = statePkg.NewKey[ProposersData](constants.FetcherNameProposers)
+
+func NewProposersFetcher(
+	logger *zerolog.Logger,
+	chains []*config.Chain,
+	rpcs map[string]*tendermint.RPCWithConsumers,
+	tracer trace.Tracer,
+) *ProposersFetcher {
+	return &ProposersFetcher{
+		Logger: logger.With().Str("component", "proposers_fetcher").Logger(),
+		Chains: chains,
+		RPCs:   rpcs,
+		Tracer: tracer,
+	}
+}
+
+func (q *ProposersFetcher) Dependencies() []constants.FetcherName {
+	return []constants.FetcherName{}
+}
+
+func (q *ProposersFetcher) Fetch(
+	ctx context.Context,
+	data ...any,
+) (ProposersData, []*types.QueryInfo) {
+	queryInfos := []*types.QueryInfo{}
+	allProposers := map[string]*types.BlockProposers{}
+
+	var (
+		wg    sync.WaitGroup
+		mutex sync.Mutex
+	)
+
+	// only the provider chains, as the voting power is only known there
+	for _, chain := range q.Chains {
+		rpc := q.RPCs[chain.Name]
+
+		wg.Add(1)
+
+		go func(rpc *tendermint.RPC, chain *config.Chain) {
+			defer wg.Done()
+
+			proposers, queries, err := rpc.GetRecentProposers(ctx)
+
+			mutex.Lock()
+			defer mutex.Unlock()
+
+			queryInfos = append(queryInfos, queries...)
+
+			if err != nil {
+				q.Logger.Error().
+					Err(err).
+					Str("chain", chain.Name).
+					Msg("Error querying for recent proposers")
+				return
+			}
+
+			if proposers == nil {
+				return
+			}
+
+			allProposers[chain.Name] = proposers
+		}(rpc.RPC, chain)
+	}
+
+	wg.Wait()
+
+	return ProposersData{Proposers: allProposers}, queryInfos
+}
+
+func (q *ProposersFetcher) Name() constants.FetcherName {
+	return constants.FetcherNameProposers
+}
@@ -0,0 +1,150 @@
+package fetchers
+
+import (
+	"context"
+	"errors"
+	"main/assets"
+	"main/pkg/config"
+	"main/pkg/constants"
+	"main/pkg/logger"
+	"main/pkg/tendermint"
+	"main/pkg/tracing"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProposersFetcherBase(t *testing.T) {
+	t.Parallel()
+
+	chains := []*config.Chain{{Name: "chain", LCDEndpoint: "example"}}
+	rpcs := map[string]*tendermint.RPCWithConsumers{
+		"chain": tendermint.RPCWithConsumersFromChain(
+			chains[0],
+			10,
+			*logger.GetNopLogger(),
+			tracing.InitNoopTracer(),
+		),
+	}
+	fetcher := NewProposersFetcher(
+		logger.GetNopLogger(),
+		chains,
+		rpcs,
+		tracing.InitNoopTracer(),
+	)
+
+	assert.NotNil(t, fetcher)
+	assert.Equal(t, constants.FetcherNameProposers, fetcher.Name())
+	assert.Empty(t, fetcher.Dependencies())
+}
+
+func TestProposersFetcherNoRPCEndpoint(t *testing.T) {
+	t.Parallel()
+
+	chains := []*config.Chain{{Name: "chain", LCDEndpoint: "example"}}
+	rpcs := map[string]*tendermint.RPCWithConsumers{
+		"chain": tendermint.RPCWithConsumersFromChain(
+			chains[0],
+			10,
+			*logger.GetNopLogger(),
+			tracing.InitNoopTracer(),
+		),
+	}
+	fetcher := &ProposersFetcher{
+		Logger: *logger.GetNopLogger(),
+		Chains: chains,
+		RPCs:   rpcs,
+		Tracer: tracing.InitNoopTracer(),
+	}
+	proposersData, queries := fetcher.Fetch(context.Background())
+	assert.Empty(t, queries)
+
+	assert.Empty(t, proposersData.Proposers)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestProposersFetcherQueryError(t *testing.T) {
+	httpmock.Activate()
+
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://rpc.cosmos.quokkastake.io/blockchain",
+		httpmock.NewErrorResponder(errors.New("error")),
+	)
+
+	chains := []*config.Chain{{
+		Name:        "chain",
+		LCDEndpoint: "https://api.cosmos.quokkastake.io",
+		RPCEndpoint: "https://rpc.cosmos.quokkastake.io",
+	}}
+	rpcs := map[string]*tendermint.RPCWithConsumers{
+		"chain": tendermint.RPCWithConsumersFromChain(
+			chains[0],
+			10,
+			*logger.GetNopLogger(),
+			tracing.InitNoopTracer(),
+		),
+	}
+	fetcher := &ProposersFetcher{
+		Logger: *logger.GetNopLogger(),
+		Chains: chains,
+		RPCs:   rpcs,
+		Tracer: tracing.InitNoopTracer(),
+	}
+	proposersData, queries := fetcher.Fetch(context.Background())
+	assert.Len(t, queries, 1)
+	assert.False(t, queries[0].Success)
+
+	assert.Empty(t, proposersData.Proposers)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestProposersFetcherQuerySuccess(t *testing.T) {
+	httpmock.Activate()
+
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://rpc.cosmos.quokkastake.io/blockchain",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("comet-blockchain-latest.json")),
+	)
+	httpmock.RegisterResponder(
+		"GET",
+		"https://rpc.cosmos.quokkastake.io/blockchain?maxHeight=80&minHeight=76",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("comet-blockchain-range.json")),
+	)
+
+	chains := []*config.Chain{{
+		Name:           "chain",
+		LCDEndpoint:    "https://api.cosmos.quokkastake.io",
+		RPCEndpoint:    "https://rpc.cosmos.quokkastake.io",
+		ProposerBlocks: 25,
+	}}
+	rpcs := map[string]*tendermint.RPCWithConsumers{
+		"chain": tendermint.RPCWithConsumersFromChain(
+			chains[0],
+			10,
+			*logger.GetNopLogger(),
+			tracing.InitNoopTracer(),
+		),
+	}
+	fetcher := &ProposersFetcher{
+		Logger: *logger.GetNopLogger(),
+		Chains: chains,
+		RPCs:   rpcs,
+		Tracer: tracing.InitNoopTracer(),
+	}
+	proposersData, queries := fetcher.Fetch(context.Background())
+	assert.Len(t, queries, 2)
+
+	proposers, ok := proposersData.Proposers["chain"]
+	assert.True(t, ok)
+	require.NotNil(t, proposers)
+	assert.Equal(t, int64(25), proposers.Blocks)
+	assert.Equal(t, int64(8), proposers.Proposed["1AEA8AD7C2BB352C01CDFD6BE21CE8E3B6FCE593"])
+}
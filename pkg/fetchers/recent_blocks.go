@@ -0,0 +1,163 @@
+package fetchers
+
+import (
+	"context"
+	"main/pkg/config"
+	"main/pkg/constants"
+	statePkg "main/pkg/state"
+	"main/pkg/tendermint"
+	"main/pkg/types"
+	"main/pkg/utils"
+	"sync"
+
+	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type RecentBlocksFetcher struct {
+	Logger zerolog.Logger
+	Chains []*config.Chain
+	RPCs   map[string]*tendermint.RPCWithConsumers
+	Tracer trace.Tracer
+}
+
+type RecentBlocksData struct {
+	Signatures map[string]map[string]*types.ValidatorSignatures
+}
+
+var RecentBlocksKey = statePkg.NewKey[RecentBlocksData](constants.FetcherNameRecentBlocks)
+
+func NewRecentBlocksFetcher(
+	logger *zerolog.Logger,
+	chains []*config.Chain,
+	rpcs map[string]*tendermint.RPCWithConsumers,
+	tracer trace.Tracer,
+) *RecentBlocksFetcher {
+	return &RecentBlocksFetcher{
+		Logger: logger.With().Str("component", "recent_blocks_fetcher").Logger(),
+		Chains: chains,
+		RPCs:   rpcs,
+		Tracer: tracer,
+	}
+}
+
+func (q *RecentBlocksFetcher) Dependencies() []constants.FetcherName {
+	return []constants.FetcherName{}
+}
+
+func (q *RecentBlocksFetcher) Fetch(
+	ctx context.Context,
+	data ...any,
+) (RecentBlocksData, []*types.QueryInfo) {
+	queryInfos := []*types.QueryInfo{}
+	allSignatures := map[string]map[string]*types.ValidatorSignatures{}
+
+	var (
+		wg    sync.WaitGroup
+		mutex sync.Mutex
+	)
+
+	addQueryInfo := func(query *types.QueryInfo) {
+		if query == nil {
+			return
+		}
+
+		mutex.Lock()
+		queryInfos = append(queryInfos, query)
+		mutex.Unlock()
+	}
+
+	processChain := func(
+		chainName string,
+		rpc *tendermint.RPC,
+		validators []config.Validator,
+		getAddresses func(validator config.Validator) (string, string, bool),
+	) {
+		defer wg.Done()
+
+		recentBlocks, queries, err := rpc.GetRecentBlocks(ctx)
+		for _, query := range queries {
+			addQueryInfo(query)
+		}
+
+		if err != nil {
+			q.Logger.Error().
+				Err(err).
+				Str("chain", chainName).
+				Msg("Error querying for recent blocks")
+			return
+		}
+
+		if recentBlocks == nil {
+			return
+		}
+
+		chainSignatures := map[string]*types.ValidatorSignatures{}
+
+		for _, validator := range validators {
+			valoper, valcons, ok := getAddresses(validator)
+			if !ok || valcons == "" {
+				continue
+			}
+
+			hexAddress, err := utils.Bech32ToHex(valcons)
+			if err != nil {
+				q.Logger.Error().
+					Err(err).
+					Str("chain", chainName).
+					Str("address", valoper).
+					Msg("Error converting valcons to hex address")
+				continue
+			}
+
+			signatures := recentBlocks.GetValidatorSignatures(hexAddress)
+			if signatures == nil {
+				continue
+			}
+
+			chainSignatures[valoper] = signatures
+		}
+
+		mutex.Lock()
+		allSignatures[chainName] = chainSignatures
+		mutex.Unlock()
+	}
+
+	for _, chain := range q.Chains {
+		rpc := q.RPCs[chain.Name]
+
+		wg.Add(1 + len(chain.ConsumerChains))
+
+		go processChain(chain.Name, rpc.RPC, chain.Validators, func(validator config.Validator) (string, string, bool) {
+			return validator.Address, validator.ConsensusAddress, true
+		})
+
+		for consumerIndex, consumerChain := range chain.ConsumerChains {
+			go processChain(
+				consumerChain.Name,
+				rpc.Consumers[consumerIndex],
+				chain.Validators,
+				func(validator config.Validator) (string, string, bool) {
+					valoper, valcons, query, ok := GetConsumerValidatorAddresses(
+						ctx,
+						validator,
+						rpc.RPC,
+						consumerChain,
+						q.Logger,
+					)
+					addQueryInfo(query)
+
+					return valoper, valcons, ok
+				},
+			)
+		}
+	}
+
+	wg.Wait()
+
+	return RecentBlocksData{Signatures: allSignatures}, queryInfos
+}
+
+func (q *RecentBlocksFetcher) Name() constants.FetcherName {
+	return constants.FetcherNameRecentBlocks
+}
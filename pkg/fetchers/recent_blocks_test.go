@@ -0,0 +1,276 @@
+package fetchers
+
+import (
+	"context"
+	"errors"
+	"main/assets"
+	"main/pkg/config"
+	"main/pkg/constants"
+	"main/pkg/logger"
+	"main/pkg/tendermint"
+	"main/pkg/tracing"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecentBlocksFetcherBase(t *testing.T) {
+	t.Parallel()
+
+	chains := []*config.Chain{{Name: "chain", LCDEndpoint: "example"}}
+	rpcs := map[string]*tendermint.RPCWithConsumers{
+		"chain": tendermint.RPCWithConsumersFromChain(
+			chains[0],
+			10,
+			*logger.GetNopLogger(),
+			tracing.InitNoopTracer(),
+		),
+	}
+	fetcher := NewRecentBlocksFetcher(
+		logger.GetNopLogger(),
+		chains,
+		rpcs,
+		tracing.InitNoopTracer(),
+	)
+
+	assert.NotNil(t, fetcher)
+	assert.Equal(t, constants.FetcherNameRecentBlocks, fetcher.Name())
+	assert.Empty(t, fetcher.Dependencies())
+}
+
+func TestRecentBlocksFetcherNoRPCEndpoint(t *testing.T) {
+	t.Parallel()
+
+	chains := []*config.Chain{{
+		Name:        "chain",
+		LCDEndpoint: "example",
+		Validators: []config.Validator{{
+			Address:          "cosmosvaloper1xqz9pemz5e5zycaa89kys5aw6m8rhgsvw4328e",
+			ConsensusAddress: "cosmosvalcons1rt4g447zhv6jcqwdl447y88guwm0eevnrelgzc",
+		}},
+	}}
+	rpcs := map[string]*tendermint.RPCWithConsumers{
+		"chain": tendermint.RPCWithConsumersFromChain(
+			chains[0],
+			10,
+			*logger.GetNopLogger(),
+			tracing.InitNoopTracer(),
+		),
+	}
+	fetcher := &RecentBlocksFetcher{
+		Logger: *logger.GetNopLogger(),
+		Chains: chains,
+		RPCs:   rpcs,
+		Tracer: tracing.InitNoopTracer(),
+	}
+	recentBlocksData, queries := fetcher.Fetch(context.Background())
+	assert.Empty(t, queries)
+
+	assert.Empty(t, recentBlocksData.Signatures)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestRecentBlocksFetcherQueryError(t *testing.T) {
+	httpmock.Activate()
+
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://rpc.cosmos.quokkastake.io/commit",
+		httpmock.NewErrorResponder(errors.New("error")),
+	)
+
+	chains := []*config.Chain{{
+		Name:         "chain",
+		LCDEndpoint:  "https://api.cosmos.quokkastake.io",
+		RPCEndpoint:  "https://rpc.cosmos.quokkastake.io",
+		RecentBlocks: 3,
+		Validators: []config.Validator{{
+			Address:          "cosmosvaloper1xqz9pemz5e5zycaa89kys5aw6m8rhgsvw4328e",
+			ConsensusAddress: "cosmosvalcons1rt4g447zhv6jcqwdl447y88guwm0eevnrelgzc",
+		}},
+	}}
+	rpcs := map[string]*tendermint.RPCWithConsumers{
+		"chain": tendermint.RPCWithConsumersFromChain(
+			chains[0],
+			10,
+			*logger.GetNopLogger(),
+			tracing.InitNoopTracer(),
+		),
+	}
+	fetcher := &RecentBlocksFetcher{
+		Logger: *logger.GetNopLogger(),
+		Chains: chains,
+		RPCs:   rpcs,
+		Tracer: tracing.InitNoopTracer(),
+	}
+	recentBlocksData, queries := fetcher.Fetch(context.Background())
+	assert.Len(t, queries, 1)
+	assert.False(t, queries[0].Success)
+
+	assert.Empty(t, recentBlocksData.Signatures)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestRecentBlocksFetcherQuerySuccess(t *testing.T) {
+	httpmock.Activate()
+
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://rpc.cosmos.quokkastake.io/commit",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("comet-recent-commit-101.json")),
+	)
+	httpmock.RegisterResponder(
+		"GET",
+		"https://rpc.cosmos.quokkastake.io/commit?height=100",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("comet-recent-commit-100.json")),
+	)
+	httpmock.RegisterResponder(
+		"GET",
+		"https://rpc.cosmos.quokkastake.io/commit?height=99",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("comet-recent-commit-99.json")),
+	)
+	httpmock.RegisterResponder(
+		"GET",
+		"https://rpc.cosmos.quokkastake.io/commit?height=98",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("comet-recent-commit-98.json")),
+	)
+	httpmock.RegisterResponder(
+		"GET",
+		"https://rpc.cosmos.quokkastake.io/validators?height=100&page=1&per_page=100",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("comet-recent-validators.json")),
+	)
+
+	chains := []*config.Chain{{
+		Name:         "chain",
+		LCDEndpoint:  "https://api.cosmos.quokkastake.io",
+		RPCEndpoint:  "https://rpc.cosmos.quokkastake.io",
+		RecentBlocks: 3,
+		Validators: []config.Validator{
+			{
+				Address:          "cosmosvaloper1xqz9pemz5e5zycaa89kys5aw6m8rhgsvw4328e",
+				ConsensusAddress: "cosmosvalcons1rt4g447zhv6jcqwdl447y88guwm0eevnrelgzc",
+			},
+			{
+				// Not in the validator set.
+				Address:          "cosmosvaloper1c4k24jzduc365kywrsvf5ujz4ya6mwympnc4en",
+				ConsensusAddress: "cosmosvalcons1c4k24jzduc365kywrsvf5ujz4ya6mwym4qtf4j",
+			},
+		},
+	}}
+	rpcs := map[string]*tendermint.RPCWithConsumers{
+		"chain": tendermint.RPCWithConsumersFromChain(
+			chains[0],
+			10,
+			*logger.GetNopLogger(),
+			tracing.InitNoopTracer(),
+		),
+	}
+	fetcher := &RecentBlocksFetcher{
+		Logger: *logger.GetNopLogger(),
+		Chains: chains,
+		RPCs:   rpcs,
+		Tracer: tracing.InitNoopTracer(),
+	}
+	recentBlocksData, queries := fetcher.Fetch(context.Background())
+	assert.Len(t, queries, 5)
+
+	chainData, ok := recentBlocksData.Signatures["chain"]
+	assert.True(t, ok)
+	assert.Len(t, chainData, 1)
+
+	signatures, ok := chainData["cosmosvaloper1xqz9pemz5e5zycaa89kys5aw6m8rhgsvw4328e"]
+	assert.True(t, ok)
+	require.NotNil(t, signatures)
+	assert.Equal(t, int64(3), signatures.Blocks)
+	assert.Equal(t, int64(2), signatures.Missed)
+	assert.Equal(t, int64(1), signatures.ConsecutiveMissed)
+	assert.Equal(t, int64(99), signatures.LastSignedHeight)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestRecentBlocksFetcherConsumer(t *testing.T) {
+	httpmock.Activate()
+
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://api.cosmos.quokkastake.io/interchain_security/ccv/provider/validator_consumer_addr/0/cosmosvalcons1rt4g447zhv6jcqwdl447y88guwm0eevnrelgzc",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("assigned-key-empty.json")),
+	)
+	httpmock.RegisterResponder(
+		"GET",
+		"https://rpc.neutron.quokkastake.io/commit",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("comet-recent-commit-101.json")),
+	)
+	httpmock.RegisterResponder(
+		"GET",
+		"https://rpc.neutron.quokkastake.io/commit?height=100",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("comet-recent-commit-100.json")),
+	)
+	httpmock.RegisterResponder(
+		"GET",
+		"https://rpc.neutron.quokkastake.io/commit?height=99",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("comet-recent-commit-99.json")),
+	)
+	httpmock.RegisterResponder(
+		"GET",
+		"https://rpc.neutron.quokkastake.io/commit?height=98",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("comet-recent-commit-98.json")),
+	)
+	httpmock.RegisterResponder(
+		"GET",
+		"https://rpc.neutron.quokkastake.io/validators?height=100&page=1&per_page=100",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("comet-recent-validators.json")),
+	)
+
+	chains := []*config.Chain{{
+		Name:        "chain",
+		LCDEndpoint: "https://api.cosmos.quokkastake.io",
+		Validators: []config.Validator{{
+			Address:          "cosmosvaloper1xqz9pemz5e5zycaa89kys5aw6m8rhgsvw4328e",
+			ConsensusAddress: "cosmosvalcons1rt4g447zhv6jcqwdl447y88guwm0eevnrelgzc",
+		}},
+		ConsumerChains: []*config.ConsumerChain{
+			{
+				Name:                "consumer",
+				ConsumerID:          "0",
+				LCDEndpoint:         "https://api.neutron.quokkastake.io",
+				RPCEndpoint:         "https://rpc.neutron.quokkastake.io",
+				RecentBlocks:        3,
+				BechConsensusPrefix: "neutronvalcons",
+				BechValidatorPrefix: "neutronvaloper",
+			},
+		},
+	}}
+	rpcs := map[string]*tendermint.RPCWithConsumers{
+		"chain": tendermint.RPCWithConsumersFromChain(
+			chains[0],
+			10,
+			*logger.GetNopLogger(),
+			tracing.InitNoopTracer(),
+		),
+	}
+	fetcher := &RecentBlocksFetcher{
+		Logger: *logger.GetNopLogger(),
+		Chains: chains,
+		RPCs:   rpcs,
+		Tracer: tracing.InitNoopTracer(),
+	}
+	recentBlocksData, queries := fetcher.Fetch(context.Background())
+	assert.Len(t, queries, 6)
+
+	chainData, ok := recentBlocksData.Signatures["consumer"]
+	assert.True(t, ok)
+
+	signatures, ok := chainData["neutronvaloper1xqz9pemz5e5zycaa89kys5aw6m8rhgsv4r4yhf"]
+	assert.True(t, ok)
+	require.NotNil(t, signatures)
+	assert.Equal(t, int64(2), signatures.Missed)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"main/pkg/config"
 	"main/pkg/constants"
+	statePkg "main/pkg/state"
 	"main/pkg/tendermint"
 	"main/pkg/types"
 	"main/pkg/utils"
@@ -24,6 +25,8 @@ type RewardsData struct {
 	Rewards map[string]map[string][]types.Amount
 }
 
+var RewardsKey = statePkg.NewKey[RewardsData](constants.FetcherNameRewards)
+
 func NewRewardsFetcher(
 	logger *zerolog.Logger,
 	chains []*config.Chain,
@@ -45,7 +48,7 @@ func (q *RewardsFetcher) Dependencies() []constants.FetcherName {
 func (q *RewardsFetcher) Fetch(
 	ctx context.Context,
 	data ...any,
-) (any, []*types.QueryInfo) {
+) (RewardsData, []*types.QueryInfo) {
 	var queryInfos []*types.QueryInfo
 
 	allRewards := map[string]map[string][]types.Amount{}
@@ -70,12 +70,9 @@ func TestRewardsFetcherNoBechWalletPrefix(t *testing.T) {
 		RPCs:   rpcs,
 		Tracer: tracing.InitNoopTracer(),
 	}
-	data, queries := fetcher.Fetch(context.Background())
+	balanceData, queries := fetcher.Fetch(context.Background())
 	assert.Empty(t, queries)
 
-	balanceData, ok := data.(RewardsData)
-	assert.True(t, ok)
-
 	chainData, ok := balanceData.Rewards["chain"]
 	assert.True(t, ok)
 	assert.Empty(t, chainData)
@@ -104,12 +101,9 @@ func TestRewardsFetcherInvalidBechWalletPrefix(t *testing.T) {
 		RPCs:   rpcs,
 		Tracer: tracing.InitNoopTracer(),
 	}
-	data, queries := fetcher.Fetch(context.Background())
+	balanceData, queries := fetcher.Fetch(context.Background())
 	assert.Empty(t, queries)
 
-	balanceData, ok := data.(RewardsData)
-	assert.True(t, ok)
-
 	chainData, ok := balanceData.Rewards["chain"]
 	assert.True(t, ok)
 	assert.Empty(t, chainData)
@@ -139,12 +133,9 @@ func TestRewardsFetcherQueryDisabled(t *testing.T) {
 		RPCs:   rpcs,
 		Tracer: tracing.InitNoopTracer(),
 	}
-	data, queries := fetcher.Fetch(context.Background())
+	balanceData, queries := fetcher.Fetch(context.Background())
 	assert.Empty(t, queries)
 
-	balanceData, ok := data.(RewardsData)
-	assert.True(t, ok)
-
 	chainData, ok := balanceData.Rewards["chain"]
 	assert.True(t, ok)
 	assert.Empty(t, chainData)
@@ -182,13 +173,10 @@ func TestRewardsFetcherQueryError(t *testing.T) {
 		RPCs:   rpcs,
 		Tracer: tracing.InitNoopTracer(),
 	}
-	data, queries := fetcher.Fetch(context.Background())
+	balanceData, queries := fetcher.Fetch(context.Background())
 	assert.Len(t, queries, 1)
 	assert.False(t, queries[0].Success)
 
-	balanceData, ok := data.(RewardsData)
-	assert.True(t, ok)
-
 	chainData, ok := balanceData.Rewards["chain"]
 	assert.True(t, ok)
 	assert.Empty(t, chainData)
@@ -226,13 +214,10 @@ func TestRewardsFetcherNodeError(t *testing.T) {
 		RPCs:   rpcs,
 		Tracer: tracing.InitNoopTracer(),
 	}
-	data, queries := fetcher.Fetch(context.Background())
+	balanceData, queries := fetcher.Fetch(context.Background())
 	assert.Len(t, queries, 1)
 	assert.False(t, queries[0].Success)
 
-	balanceData, ok := data.(RewardsData)
-	assert.True(t, ok)
-
 	chainData, ok := balanceData.Rewards["chain"]
 	assert.True(t, ok)
 	assert.Empty(t, chainData)
@@ -270,13 +255,10 @@ func TestRewardsFetcherQuerySuccess(t *testing.T) {
 		RPCs:   rpcs,
 		Tracer: tracing.InitNoopTracer(),
 	}
-	data, queries := fetcher.Fetch(context.Background())
+	balanceData, queries := fetcher.Fetch(context.Background())
 	assert.Len(t, queries, 1)
 	assert.True(t, queries[0].Success)
 
-	balanceData, ok := data.(RewardsData)
-	assert.True(t, ok)
-
 	chainData, ok := balanceData.Rewards["chain"]
 	assert.True(t, ok)
 
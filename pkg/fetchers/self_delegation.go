@@ -4,6 +4,7 @@ import (
 	"context"
 	"main/pkg/config"
 	"main/pkg/constants"
+	statePkg "main/pkg/state"
 	"main/pkg/tendermint"
 	"main/pkg/types"
 	"main/pkg/utils"
@@ -24,6 +25,8 @@ type SelfDelegationData struct {
 	Delegations map[string]map[string]*types.Amount
 }
 
+var SelfDelegationKey = statePkg.NewKey[SelfDelegationData](constants.FetcherNameSelfDelegation)
+
 func NewSelfDelegationFetcher(
 	logger *zerolog.Logger,
 	chains []*config.Chain,
@@ -45,7 +48,7 @@ func (q *SelfDelegationFetcher) Dependencies() []constants.FetcherName {
 func (q *SelfDelegationFetcher) Fetch(
 	ctx context.Context,
 	data ...any,
-) (any, []*types.QueryInfo) {
+) (SelfDelegationData, []*types.QueryInfo) {
 	var queryInfos []*types.QueryInfo
 
 	allSelfDelegations := map[string]map[string]*types.Amount{}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"main/pkg/config"
 	"main/pkg/constants"
+	statePkg "main/pkg/state"
 	"main/pkg/tendermint"
 	"main/pkg/types"
 	"main/pkg/utils"
@@ -18,18 +19,14 @@ type SigningInfoFetcher struct {
 	Chains []*config.Chain
 	RPCs   map[string]*tendermint.RPCWithConsumers
 	Tracer trace.Tracer
-
-	wg    sync.WaitGroup
-	mutex sync.Mutex
-
-	queryInfos      []*types.QueryInfo
-	allSigningInfos map[string]map[string]*types.SigningInfoResponse
 }
 
 type SigningInfoData struct {
 	SigningInfos map[string]map[string]*types.SigningInfoResponse
 }
 
+var SigningInfoKey = statePkg.NewKey[SigningInfoData](constants.FetcherNameSigningInfo)
+
 func NewSigningInfoFetcher(
 	logger *zerolog.Logger,
 	chains []*config.Chain,
@@ -51,67 +48,108 @@ func (q *SigningInfoFetcher) Dependencies() []constants.FetcherName {
 func (q *SigningInfoFetcher) Fetch(
 	ctx context.Context,
 	data ...any,
-) (any, []*types.QueryInfo) {
-	q.queryInfos = []*types.QueryInfo{}
-	q.allSigningInfos = map[string]map[string]*types.SigningInfoResponse{}
+) (SigningInfoData, []*types.QueryInfo) {
+	queryInfos := []*types.QueryInfo{}
+	allSigningInfos := map[string]map[string]*types.SigningInfoResponse{}
 
 	for _, chain := range q.Chains {
-		q.allSigningInfos[chain.Name] = map[string]*types.SigningInfoResponse{}
+		allSigningInfos[chain.Name] = map[string]*types.SigningInfoResponse{}
 		for _, consumerChain := range chain.ConsumerChains {
-			q.allSigningInfos[consumerChain.Name] = map[string]*types.SigningInfoResponse{}
+			allSigningInfos[consumerChain.Name] = map[string]*types.SigningInfoResponse{}
+		}
+	}
+
+	var (
+		wg    sync.WaitGroup
+		mutex sync.Mutex
+	)
+
+	addQueryInfo := func(query *types.QueryInfo) {
+		if query == nil {
+			return
+		}
+
+		mutex.Lock()
+		queryInfos = append(queryInfos, query)
+		mutex.Unlock()
+	}
+
+	processChain := func(
+		valoper string,
+		valcons string,
+		chainName string,
+		rpc *tendermint.RPC,
+	) {
+		signingInfo, query, ok := q.fetchSigningInfo(ctx, valoper, valcons, chainName, rpc)
+		addQueryInfo(query)
+
+		if !ok {
+			return
 		}
+
+		mutex.Lock()
+		allSigningInfos[chainName][valoper] = signingInfo
+		mutex.Unlock()
 	}
 
 	for _, chain := range q.Chains {
 		rpc := q.RPCs[chain.Name]
 
 		for _, validator := range chain.Validators {
-			q.wg.Add(1 + len(rpc.Consumers))
+			wg.Add(1 + len(rpc.Consumers))
 
-			go q.processProviderChain(
-				ctx,
-				validator,
-				chain.Name,
-				rpc.RPC,
-			)
+			go func(validator config.Validator) {
+				defer wg.Done()
+
+				processChain(validator.Address, validator.ConsensusAddress, chain.Name, rpc.RPC)
+			}(validator)
 
 			for consumerIndex, consumerChain := range chain.ConsumerChains {
 				consumerRPC := rpc.Consumers[consumerIndex]
 
-				go q.processConsumerChain(ctx, validator, consumerRPC, rpc.RPC, consumerChain)
+				go func(validator config.Validator, consumerChain *config.ConsumerChain) {
+					defer wg.Done()
+
+					valoper, valcons, query, ok := GetConsumerValidatorAddresses(
+						ctx,
+						validator,
+						rpc.RPC,
+						consumerChain,
+						q.Logger,
+					)
+					addQueryInfo(query)
+
+					if !ok {
+						return
+					}
+
+					processChain(valoper, valcons, consumerChain.Name, consumerRPC)
+				}(validator, consumerChain)
 			}
 		}
 	}
 
-	q.wg.Wait()
+	wg.Wait()
 
-	return SigningInfoData{SigningInfos: q.allSigningInfos}, q.queryInfos
+	return SigningInfoData{SigningInfos: allSigningInfos}, queryInfos
 }
 
 func (q *SigningInfoFetcher) Name() constants.FetcherName {
 	return constants.FetcherNameSigningInfo
 }
 
-func (q *SigningInfoFetcher) fetchAndSetSigningInfo(
+func (q *SigningInfoFetcher) fetchSigningInfo(
 	ctx context.Context,
 	valoper string,
 	valcons string,
 	chainName string,
 	rpc *tendermint.RPC,
-) {
+) (*types.SigningInfoResponse, *types.QueryInfo, bool) {
 	if valcons == "" {
-		return
+		return nil, nil, false
 	}
 
 	signingInfo, signingInfoQuery, err := rpc.GetSigningInfo(valcons, ctx)
-
-	q.mutex.Lock()
-	defer q.mutex.Unlock()
-
-	if signingInfoQuery != nil {
-		q.queryInfos = append(q.queryInfos, signingInfoQuery)
-	}
-
 	if err != nil {
 		q.Logger.Error().
 			Err(err).
@@ -119,40 +157,21 @@ func (q *SigningInfoFetcher) fetchAndSetSigningInfo(
 			Str("address", valoper).
 			Msg("Error getting validator signing info")
 
-		return
+		return nil, signingInfoQuery, false
 	}
 
-	q.allSigningInfos[chainName][valoper] = signingInfo
+	return signingInfo, signingInfoQuery, true
 }
 
-func (q *SigningInfoFetcher) processProviderChain(
+func GetConsumerValidatorAddresses(
 	ctx context.Context,
 	validator config.Validator,
-	chainName string,
-	rpc *tendermint.RPC,
-) {
-	defer q.wg.Done()
-
-	q.fetchAndSetSigningInfo(
-		ctx,
-		validator.Address,
-		validator.ConsensusAddress,
-		chainName,
-		rpc,
-	)
-}
-
-func (q *SigningInfoFetcher) processConsumerChain(
-	ctx context.Context,
-	validator config.Validator,
-	rpc *tendermint.RPC,
 	providerRPC *tendermint.RPC,
 	chain *config.ConsumerChain,
-) {
-	defer q.wg.Done()
-
+	logger zerolog.Logger,
+) (string, string, *types.QueryInfo, bool) {
 	if chain.BechConsensusPrefix == "" || chain.BechValidatorPrefix == "" {
-		return
+		return "", "", nil, false
 	}
 
 	// 1. Fetching assigned key.
@@ -161,21 +180,19 @@ func (q *SigningInfoFetcher) processConsumerChain(
 		chain.ConsumerID,
 		ctx,
 	)
-
-	q.mutex.Lock()
 	if queryInfo != nil {
-		q.queryInfos = append(q.queryInfos, queryInfo)
+		// the query is sent to the provider, but it's the consumer's data that depends on it
+		queryInfo.Chain = chain.Name
 	}
-	q.mutex.Unlock()
 
 	if err != nil {
-		q.Logger.Error().
+		logger.Error().
 			Err(err).
 			Str("chain", chain.Name).
 			Str("address", validator.Address).
 			Msg("Error getting validator assigned key")
 
-		return
+		return "", "", queryInfo, false
 	}
 
 	valconsProvider := validator.ConsensusAddress
@@ -186,33 +203,26 @@ func (q *SigningInfoFetcher) processConsumerChain(
 	// 2. Converting it to bech32 prefix of the consumer chain.
 	valcons, err := utils.ChangeBech32Prefix(valconsProvider, chain.BechConsensusPrefix)
 	if err != nil {
-		q.Logger.Error().
+		logger.Error().
 			Err(err).
 			Str("chain", chain.Name).
 			Str("address", validator.Address).
 			Msg("Error converting valcons prefix")
 
-		return
+		return "", "", queryInfo, false
 	}
 
 	// 3. Converting valoper address on a provider chain to the one on a consumer chain.
 	valoper, err := utils.ChangeBech32Prefix(validator.Address, chain.BechValidatorPrefix)
 	if err != nil {
-		q.Logger.Error().
+		logger.Error().
 			Err(err).
 			Str("chain", chain.Name).
 			Str("address", validator.Address).
 			Msg("Error converting valoper prefix")
 
-		return
+		return "", "", queryInfo, false
 	}
 
-	// 4. Querying the signing-info on consumer chain.
-	q.fetchAndSetSigningInfo(
-		ctx,
-		valoper,
-		valcons,
-		chain.Name,
-		rpc,
-	)
+	return valoper, valcons, queryInfo, true
 }
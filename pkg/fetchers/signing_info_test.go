@@ -9,6 +9,7 @@ import (
 	"main/pkg/logger"
 	"main/pkg/tendermint"
 	"main/pkg/tracing"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -73,12 +74,9 @@ func TestSigningInfoFetcherNoValcons(t *testing.T) {
 		RPCs:   rpcs,
 		Tracer: tracing.InitNoopTracer(),
 	}
-	data, queries := fetcher.Fetch(context.Background())
+	paramsData, queries := fetcher.Fetch(context.Background())
 	assert.Empty(t, queries)
 
-	paramsData, ok := data.(SigningInfoData)
-	assert.True(t, ok)
-
 	chainData, ok := paramsData.SigningInfos["chain"]
 	assert.True(t, ok)
 	assert.Empty(t, chainData)
@@ -111,12 +109,9 @@ func TestSigningInfoFetcherQueryDisabled(t *testing.T) {
 		RPCs:   rpcs,
 		Tracer: tracing.InitNoopTracer(),
 	}
-	data, queries := fetcher.Fetch(context.Background())
+	paramsData, queries := fetcher.Fetch(context.Background())
 	assert.Empty(t, queries)
 
-	paramsData, ok := data.(SigningInfoData)
-	assert.True(t, ok)
-
 	chainData, ok := paramsData.SigningInfos["chain"]
 	assert.True(t, ok)
 
@@ -160,13 +155,10 @@ func TestSigningInfoFetcherQueryError(t *testing.T) {
 		RPCs:   rpcs,
 		Tracer: tracing.InitNoopTracer(),
 	}
-	data, queries := fetcher.Fetch(context.Background())
+	paramsData, queries := fetcher.Fetch(context.Background())
 	assert.Len(t, queries, 1)
 	assert.False(t, queries[0].Success)
 
-	paramsData, ok := data.(SigningInfoData)
-	assert.True(t, ok)
-
 	chainData, ok := paramsData.SigningInfos["chain"]
 	assert.True(t, ok)
 	assert.Empty(t, chainData)
@@ -207,13 +199,10 @@ func TestSigningInfoFetcherNodeError(t *testing.T) {
 		RPCs:   rpcs,
 		Tracer: tracing.InitNoopTracer(),
 	}
-	data, queries := fetcher.Fetch(context.Background())
+	paramsData, queries := fetcher.Fetch(context.Background())
 	assert.Len(t, queries, 1)
 	assert.False(t, queries[0].Success)
 
-	paramsData, ok := data.(SigningInfoData)
-	assert.True(t, ok)
-
 	chainData, ok := paramsData.SigningInfos["chain"]
 	assert.True(t, ok)
 	assert.Empty(t, chainData)
@@ -254,13 +243,10 @@ func TestSigningInfoFetcherQuerySuccess(t *testing.T) {
 		RPCs:   rpcs,
 		Tracer: tracing.InitNoopTracer(),
 	}
-	data, queries := fetcher.Fetch(context.Background())
+	infosData, queries := fetcher.Fetch(context.Background())
 	assert.Len(t, queries, 1)
 	assert.True(t, queries[0].Success)
 
-	infosData, ok := data.(SigningInfoData)
-	assert.True(t, ok)
-
 	chainData, ok := infosData.SigningInfos["chain"]
 	assert.True(t, ok)
 
@@ -303,12 +289,9 @@ func TestSigningInfoFetcherConsumerNoBechConsensusPrefix(t *testing.T) {
 		RPCs:   rpcs,
 		Tracer: tracing.InitNoopTracer(),
 	}
-	data, queries := fetcher.Fetch(context.Background())
+	paramsData, queries := fetcher.Fetch(context.Background())
 	assert.Empty(t, queries)
 
-	paramsData, ok := data.(SigningInfoData)
-	assert.True(t, ok)
-
 	chainData, ok := paramsData.SigningInfos["chain"]
 	assert.True(t, ok)
 
@@ -363,12 +346,10 @@ func TestSigningInfoFetcherConsumerAssignedKeyQueryError(t *testing.T) {
 		RPCs:   rpcs,
 		Tracer: tracing.InitNoopTracer(),
 	}
-	data, queries := fetcher.Fetch(context.Background())
+	infosData, queries := fetcher.Fetch(context.Background())
 	assert.Len(t, queries, 1)
 	assert.False(t, queries[0].Success)
-
-	infosData, ok := data.(SigningInfoData)
-	assert.True(t, ok)
+	assert.Equal(t, "consumer", queries[0].Chain)
 
 	chainData, ok := infosData.SigningInfos["consumer"]
 	assert.True(t, ok)
@@ -421,13 +402,10 @@ func TestSigningInfoFetcherConsumerAssignedKeyNodeError(t *testing.T) {
 		RPCs:   rpcs,
 		Tracer: tracing.InitNoopTracer(),
 	}
-	data, queries := fetcher.Fetch(context.Background())
+	infosData, queries := fetcher.Fetch(context.Background())
 	assert.Len(t, queries, 1)
 	assert.False(t, queries[0].Success)
 
-	infosData, ok := data.(SigningInfoData)
-	assert.True(t, ok)
-
 	chainData, ok := infosData.SigningInfos["consumer"]
 	assert.True(t, ok)
 	assert.Empty(t, chainData)
@@ -469,12 +447,9 @@ func TestSigningInfoFetcherConsumerAssignedKeyInvalidValcons(t *testing.T) {
 		RPCs:   rpcs,
 		Tracer: tracing.InitNoopTracer(),
 	}
-	data, queries := fetcher.Fetch(context.Background())
+	infosData, queries := fetcher.Fetch(context.Background())
 	assert.Empty(t, queries)
 
-	infosData, ok := data.(SigningInfoData)
-	assert.True(t, ok)
-
 	chainData, ok := infosData.SigningInfos["chain"]
 	assert.True(t, ok)
 
@@ -529,13 +504,10 @@ func TestSigningInfoFetcherConsumerInvalidValoper(t *testing.T) {
 		RPCs:   rpcs,
 		Tracer: tracing.InitNoopTracer(),
 	}
-	data, queries := fetcher.Fetch(context.Background())
+	infosData, queries := fetcher.Fetch(context.Background())
 	assert.Len(t, queries, 1)
 	assert.True(t, queries[0].Success)
 
-	infosData, ok := data.(SigningInfoData)
-	assert.True(t, ok)
-
 	chainData, ok := infosData.SigningInfos["consumer"]
 	assert.True(t, ok)
 	assert.Empty(t, chainData)
@@ -593,14 +565,11 @@ func TestSigningInfoFetcherConsumerSuccessWithAssignedKey(t *testing.T) {
 		RPCs:   rpcs,
 		Tracer: tracing.InitNoopTracer(),
 	}
-	data, queries := fetcher.Fetch(context.Background())
+	infosData, queries := fetcher.Fetch(context.Background())
 	assert.Len(t, queries, 2)
 	assert.True(t, queries[0].Success)
 	assert.True(t, queries[0].Success)
 
-	infosData, ok := data.(SigningInfoData)
-	assert.True(t, ok)
-
 	chainData, ok := infosData.SigningInfos["consumer"]
 	assert.True(t, ok)
 
@@ -661,14 +630,11 @@ func TestSigningInfoFetcherConsumerSuccessWithoutAssignedKey(t *testing.T) {
 		RPCs:   rpcs,
 		Tracer: tracing.InitNoopTracer(),
 	}
-	data, queries := fetcher.Fetch(context.Background())
+	infosData, queries := fetcher.Fetch(context.Background())
 	assert.Len(t, queries, 2)
 	assert.True(t, queries[0].Success)
 	assert.True(t, queries[0].Success)
 
-	infosData, ok := data.(SigningInfoData)
-	assert.True(t, ok)
-
 	chainData, ok := infosData.SigningInfos["consumer"]
 	assert.True(t, ok)
 
@@ -723,13 +689,10 @@ func TestSigningInfoFetcherConsumerSuccessWithAssignedKeyQueryDisabled(t *testin
 		RPCs:   rpcs,
 		Tracer: tracing.InitNoopTracer(),
 	}
-	data, queries := fetcher.Fetch(context.Background())
+	infosData, queries := fetcher.Fetch(context.Background())
 	assert.Len(t, queries, 1)
 	assert.True(t, queries[0].Success)
 
-	infosData, ok := data.(SigningInfoData)
-	assert.True(t, ok)
-
 	chainData, ok := infosData.SigningInfos["consumer"]
 	assert.True(t, ok)
 
@@ -737,3 +700,57 @@ func TestSigningInfoFetcherConsumerSuccessWithAssignedKeyQueryDisabled(t *testin
 	assert.True(t, ok)
 	assert.Equal(t, int64(8), validatorData.ValSigningInfo.MissedBlocksCounter.Int64(), 0.01)
 }
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestSigningInfoFetcherConcurrentFetches(t *testing.T) {
+	httpmock.Activate()
+
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://api.cosmos.quokkastake.io/cosmos/slashing/v1beta1/signing_infos/cosmosvalcons1rt4g447zhv6jcqwdl447y88guwm0eevnrelgzc",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("signing-info.json")),
+	)
+
+	chains := []*config.Chain{{
+		Name:             "chain",
+		LCDEndpoint:      "https://api.cosmos.quokkastake.io",
+		BechWalletPrefix: "cosmos",
+		Validators: []config.Validator{{
+			Address:          "cosmosvaloper1xqz9pemz5e5zycaa89kys5aw6m8rhgsvw4328e",
+			ConsensusAddress: "cosmosvalcons1rt4g447zhv6jcqwdl447y88guwm0eevnrelgzc",
+		}},
+	}}
+	rpcs := map[string]*tendermint.RPCWithConsumers{
+		"chain": tendermint.RPCWithConsumersFromChain(
+			chains[0],
+			10,
+			*logger.GetNopLogger(),
+			tracing.InitNoopTracer(),
+		),
+	}
+	fetcher := NewSigningInfoFetcher(
+		logger.GetNopLogger(),
+		chains,
+		rpcs,
+		tracing.InitNoopTracer(),
+	)
+
+	var wg sync.WaitGroup
+
+	for range 10 {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			infosData, queries := fetcher.Fetch(context.Background())
+			assert.Len(t, queries, 1)
+
+			assert.Len(t, infosData.SigningInfos["chain"], 1)
+		}()
+	}
+
+	wg.Wait()
+}
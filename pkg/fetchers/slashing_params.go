@@ -4,6 +4,7 @@ import (
 	"context"
 	"main/pkg/config"
 	"main/pkg/constants"
+	statePkg "main/pkg/state"
 	"main/pkg/tendermint"
 	"main/pkg/types"
 	"sync"
@@ -17,18 +18,14 @@ type SlashingParamsFetcher struct {
 	Chains []*config.Chain
 	RPCs   map[string]*tendermint.RPCWithConsumers
 	Tracer trace.Tracer
-
-	wg    sync.WaitGroup
-	mutex sync.Mutex
-
-	queryInfos []*types.QueryInfo
-	allParams  map[string]*types.SlashingParamsResponse
 }
 
 type SlashingParamsData struct {
 	Params map[string]*types.SlashingParamsResponse
 }
 
+var SlashingParamsKey = statePkg.NewKey[SlashingParamsData](constants.FetcherNameSlashingParams)
+
 func NewSlashingParamsFetcher(
 	logger *zerolog.Logger,
 	chains []*config.Chain,
@@ -50,58 +47,60 @@ func (q *SlashingParamsFetcher) Dependencies() []constants.FetcherName {
 func (q *SlashingParamsFetcher) Fetch(
 	ctx context.Context,
 	data ...any,
-) (any, []*types.QueryInfo) {
-	q.queryInfos = []*types.QueryInfo{}
-	q.allParams = map[string]*types.SlashingParamsResponse{}
+) (SlashingParamsData, []*types.QueryInfo) {
+	queryInfos := []*types.QueryInfo{}
+	allParams := map[string]*types.SlashingParamsResponse{}
 
-	for _, chain := range q.Chains {
-		rpc := q.RPCs[chain.Name]
+	var (
+		wg    sync.WaitGroup
+		mutex sync.Mutex
+	)
 
-		q.wg.Add(1 + len(chain.ConsumerChains))
+	processChain := func(chainName string, rpc *tendermint.RPC) {
+		defer wg.Done()
 
-		go q.processChain(ctx, chain.Name, rpc.RPC)
+		params, query, err := rpc.GetSlashingParams(ctx)
 
-		for consumerIndex, consumerChain := range chain.ConsumerChains {
-			consumerRPC := rpc.Consumers[consumerIndex]
-			go q.processChain(ctx, consumerChain.Name, consumerRPC)
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		if query != nil {
+			queryInfos = append(queryInfos, query)
 		}
-	}
 
-	q.wg.Wait()
+		if err != nil {
+			q.Logger.Error().
+				Err(err).
+				Str("chain", chainName).
+				Msg("Error querying slashing params")
 
-	return SlashingParamsData{Params: q.allParams}, q.queryInfos
-}
+			return
+		}
 
-func (q *SlashingParamsFetcher) Name() constants.FetcherName {
-	return constants.FetcherNameSlashingParams
-}
+		if params == nil {
+			return
+		}
 
-func (q *SlashingParamsFetcher) processChain(
-	ctx context.Context,
-	chainName string,
-	rpc *tendermint.RPC,
-) {
-	defer q.wg.Done()
+		allParams[chainName] = params
+	}
 
-	params, query, err := rpc.GetSlashingParams(ctx)
+	for _, chain := range q.Chains {
+		rpc := q.RPCs[chain.Name]
+
+		wg.Add(1 + len(chain.ConsumerChains))
 
-	q.mutex.Lock()
-	defer q.mutex.Unlock()
+		go processChain(chain.Name, rpc.RPC)
 
-	if query != nil {
-		q.queryInfos = append(q.queryInfos, query)
+		for consumerIndex, consumerChain := range chain.ConsumerChains {
+			go processChain(consumerChain.Name, rpc.Consumers[consumerIndex])
+		}
 	}
 
-	if err != nil {
-		q.Logger.Error().
-			Err(err).
-			Str("chain", chainName).
-			Msg("Error querying slashing params")
+	wg.Wait()
 
-		return
-	}
+	return SlashingParamsData{Params: allParams}, queryInfos
+}
 
-	if params != nil {
-		q.allParams[chainName] = params
-	}
+func (q *SlashingParamsFetcher) Name() constants.FetcherName {
+	return constants.FetcherNameSlashingParams
 }
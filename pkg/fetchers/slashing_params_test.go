@@ -72,11 +72,9 @@ func TestSlashingParamsFetcherQueryDisabled(t *testing.T) {
 		RPCs:   rpcs,
 		Tracer: tracing.InitNoopTracer(),
 	}
-	data, queries := fetcher.Fetch(context.Background())
+	paramsData, queries := fetcher.Fetch(context.Background())
 	assert.Empty(t, queries)
 
-	paramsData, ok := data.(SlashingParamsData)
-	assert.True(t, ok)
 	assert.Empty(t, paramsData.Params)
 }
 
@@ -112,12 +110,10 @@ func TestSlashingParamsFetcherQueryError(t *testing.T) {
 		RPCs:   rpcs,
 		Tracer: tracing.InitNoopTracer(),
 	}
-	data, queries := fetcher.Fetch(context.Background())
+	paramsData, queries := fetcher.Fetch(context.Background())
 	assert.Len(t, queries, 1)
 	assert.False(t, queries[0].Success)
 
-	paramsData, ok := data.(SlashingParamsData)
-	assert.True(t, ok)
 	assert.Empty(t, paramsData.Params)
 }
 
@@ -153,12 +149,10 @@ func TestSlashingParamsFetcherNodeError(t *testing.T) {
 		RPCs:   rpcs,
 		Tracer: tracing.InitNoopTracer(),
 	}
-	data, queries := fetcher.Fetch(context.Background())
+	paramsData, queries := fetcher.Fetch(context.Background())
 	assert.Len(t, queries, 1)
 	assert.False(t, queries[0].Success)
 
-	paramsData, ok := data.(SlashingParamsData)
-	assert.True(t, ok)
 	assert.Empty(t, paramsData.Params)
 }
 
@@ -194,13 +188,10 @@ func TestSlashingParamsFetcherQuerySuccess(t *testing.T) {
 		RPCs:   rpcs,
 		Tracer: tracing.InitNoopTracer(),
 	}
-	data, queries := fetcher.Fetch(context.Background())
+	paramsData, queries := fetcher.Fetch(context.Background())
 	assert.Len(t, queries, 1)
 	assert.True(t, queries[0].Success)
 
-	paramsData, ok := data.(SlashingParamsData)
-	assert.True(t, ok)
-
 	chainData, ok := paramsData.Params["chain"]
 	assert.True(t, ok)
 	assert.Equal(t, int64(10000), chainData.SlashingParams.SignedBlocksWindow.Int64())
@@ -246,13 +237,10 @@ func TestSlashingParamsFetcherConsumer(t *testing.T) {
 		RPCs:   rpcs,
 		Tracer: tracing.InitNoopTracer(),
 	}
-	data, queries := fetcher.Fetch(context.Background())
+	paramsData, queries := fetcher.Fetch(context.Background())
 	assert.Len(t, queries, 1)
 	assert.True(t, queries[0].Success)
 
-	paramsData, ok := data.(SlashingParamsData)
-	assert.True(t, ok)
-
 	chainData, ok := paramsData.Params["consumer"]
 	assert.True(t, ok)
 	assert.Equal(t, int64(10000), chainData.SlashingParams.SignedBlocksWindow.Int64())
@@ -4,6 +4,7 @@ import (
 	"context"
 	"main/pkg/config"
 	"main/pkg/constants"
+	statePkg "main/pkg/state"
 	"main/pkg/tendermint"
 	"main/pkg/types"
 	"sync"
@@ -23,6 +24,8 @@ type StakingParamsData struct {
 	Params map[string]*types.StakingParamsResponse
 }
 
+var StakingParamsKey = statePkg.NewKey[StakingParamsData](constants.FetcherNameStakingParams)
+
 func NewStakingParamsFetcher(
 	logger *zerolog.Logger,
 	chains []*config.Chain,
@@ -44,7 +47,7 @@ func (q *StakingParamsFetcher) Dependencies() []constants.FetcherName {
 func (q *StakingParamsFetcher) Fetch(
 	ctx context.Context,
 	data ...any,
-) (any, []*types.QueryInfo) {
+) (StakingParamsData, []*types.QueryInfo) {
 	var queryInfos []*types.QueryInfo
 
 	allParams := map[string]*types.StakingParamsResponse{}
@@ -72,11 +72,9 @@ func TestStakingParamsFetcherQueryDisabled(t *testing.T) {
 		RPCs:   rpcs,
 		Tracer: tracing.InitNoopTracer(),
 	}
-	data, queries := fetcher.Fetch(context.Background())
+	paramsData, queries := fetcher.Fetch(context.Background())
 	assert.Empty(t, queries)
 
-	paramsData, ok := data.(StakingParamsData)
-	assert.True(t, ok)
 	assert.Empty(t, paramsData.Params)
 }
 
@@ -112,12 +110,10 @@ func TestStakingParamsFetcherQueryError(t *testing.T) {
 		RPCs:   rpcs,
 		Tracer: tracing.InitNoopTracer(),
 	}
-	data, queries := fetcher.Fetch(context.Background())
+	paramsData, queries := fetcher.Fetch(context.Background())
 	assert.Len(t, queries, 1)
 	assert.False(t, queries[0].Success)
 
-	paramsData, ok := data.(StakingParamsData)
-	assert.True(t, ok)
 	assert.Empty(t, paramsData.Params)
 }
 
@@ -153,12 +149,10 @@ func TestStakingParamsFetcherNodeError(t *testing.T) {
 		RPCs:   rpcs,
 		Tracer: tracing.InitNoopTracer(),
 	}
-	data, queries := fetcher.Fetch(context.Background())
+	paramsData, queries := fetcher.Fetch(context.Background())
 	assert.Len(t, queries, 1)
 	assert.False(t, queries[0].Success)
 
-	paramsData, ok := data.(StakingParamsData)
-	assert.True(t, ok)
 	assert.Empty(t, paramsData.Params)
 }
 
@@ -197,13 +191,10 @@ func TestStakingParamsFetcherQuerySuccess(t *testing.T) {
 		RPCs:   rpcs,
 		Tracer: tracing.InitNoopTracer(),
 	}
-	data, queries := fetcher.Fetch(context.Background())
+	paramsData, queries := fetcher.Fetch(context.Background())
 	assert.Len(t, queries, 1)
 	assert.True(t, queries[0].Success)
 
-	paramsData, ok := data.(StakingParamsData)
-	assert.True(t, ok)
-
 	chainData, ok := paramsData.Params["chain"]
 	assert.True(t, ok)
 	assert.NotNil(t, chainData)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"main/pkg/config"
 	"main/pkg/constants"
+	statePkg "main/pkg/state"
 	"main/pkg/tendermint"
 	"main/pkg/types"
 	"sync"
@@ -17,18 +18,14 @@ type SupplyFetcher struct {
 	Chains []*config.Chain
 	RPCs   map[string]*tendermint.RPCWithConsumers
 	Tracer trace.Tracer
-
-	wg    sync.WaitGroup
-	mutex sync.Mutex
-
-	queryInfos  []*types.QueryInfo
-	allSupplies map[string][]types.Amount
 }
 
 type SupplyData struct {
 	Supplies map[string][]types.Amount
 }
 
+var SupplyKey = statePkg.NewKey[SupplyData](constants.FetcherNameSupply)
+
 func NewSupplyFetcher(
 	logger *zerolog.Logger,
 	chains []*config.Chain,
@@ -50,69 +47,60 @@ func (q *SupplyFetcher) Dependencies() []constants.FetcherName {
 func (q *SupplyFetcher) Fetch(
 	ctx context.Context,
 	data ...any,
-) (any, []*types.QueryInfo) {
-	q.queryInfos = []*types.QueryInfo{}
-	q.allSupplies = map[string][]types.Amount{}
+) (SupplyData, []*types.QueryInfo) {
+	queryInfos := []*types.QueryInfo{}
+	allSupplies := map[string][]types.Amount{}
 
-	for _, chain := range q.Chains {
-		q.wg.Add(1 + len(chain.ConsumerChains))
+	var (
+		wg    sync.WaitGroup
+		mutex sync.Mutex
+	)
 
-		rpc := q.RPCs[chain.Name]
+	processChain := func(chainName string, rpc *tendermint.RPC) {
+		defer wg.Done()
 
-		go q.processChain(
-			ctx,
-			chain.Name,
-			rpc.RPC,
-		)
+		supply, query, err := rpc.GetTotalSupply(ctx)
 
-		for consumerIndex, consumerChain := range chain.ConsumerChains {
-			consumerRPC := rpc.Consumers[consumerIndex]
+		mutex.Lock()
+		defer mutex.Unlock()
 
-			go q.processChain(
-				ctx,
-				consumerChain.Name,
-				consumerRPC,
-			)
+		if query != nil {
+			queryInfos = append(queryInfos, query)
 		}
-	}
 
-	q.wg.Wait()
+		if err != nil {
+			q.Logger.Error().
+				Err(err).
+				Str("chain", chainName).
+				Msg("Error querying for chain supply")
 
-	return SupplyData{Supplies: q.allSupplies}, q.queryInfos
-}
-
-func (q *SupplyFetcher) Name() constants.FetcherName {
-	return constants.FetcherNameSupply
-}
+			return
+		}
 
-func (q *SupplyFetcher) processChain(
-	ctx context.Context,
-	chainName string,
-	rpc *tendermint.RPC,
-) {
-	defer q.wg.Done()
+		if supply == nil {
+			return
+		}
 
-	supply, query, err := rpc.GetTotalSupply(ctx)
+		allSupplies[chainName] = supply
+	}
 
-	q.mutex.Lock()
-	defer q.mutex.Unlock()
+	for _, chain := range q.Chains {
+		rpc := q.RPCs[chain.Name]
 
-	if query != nil {
-		q.queryInfos = append(q.queryInfos, query)
-	}
+		wg.Add(1 + len(chain.ConsumerChains))
 
-	if err != nil {
-		q.Logger.Error().
-			Err(err).
-			Str("chain", chainName).
-			Msg("Error querying for chain supply")
+		go processChain(chain.Name, rpc.RPC)
 
-		return
+		for consumerIndex, consumerChain := range chain.ConsumerChains {
+			go processChain(consumerChain.Name, rpc.Consumers[consumerIndex])
+		}
 	}
 
-	if supply == nil {
-		return
-	}
+	wg.Wait()
+
+	return SupplyData{Supplies: allSupplies}, queryInfos
+}
 
-	q.allSupplies[chainName] = supply
+func (q *SupplyFetcher) Name() constants.FetcherName {
+	return constants.FetcherNameSupply
 }
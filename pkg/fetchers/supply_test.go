@@ -71,11 +71,9 @@ func TestSupplyFetcherQueryDisabled(t *testing.T) {
 		RPCs:   rpcs,
 		Tracer: tracing.InitNoopTracer(),
 	}
-	data, queries := fetcher.Fetch(context.Background())
+	supplyData, queries := fetcher.Fetch(context.Background())
 	assert.Empty(t, queries)
 
-	supplyData, ok := data.(SupplyData)
-	assert.True(t, ok)
 	assert.Empty(t, supplyData.Supplies)
 }
 
@@ -87,7 +85,7 @@ func TestSupplyFetcherQueryError(t *testing.T) {
 
 	httpmock.RegisterResponder(
 		"GET",
-		"https://api.cosmos.quokkastake.io/cosmos/bank/v1beta1/supply?pagination.limit=10000&pagination.offset=0",
+		"https://api.cosmos.quokkastake.io/cosmos/bank/v1beta1/supply?pagination.limit=1000",
 		httpmock.NewErrorResponder(errors.New("error")),
 	)
 
@@ -110,12 +108,10 @@ func TestSupplyFetcherQueryError(t *testing.T) {
 		RPCs:   rpcs,
 		Tracer: tracing.InitNoopTracer(),
 	}
-	data, queries := fetcher.Fetch(context.Background())
+	supplyData, queries := fetcher.Fetch(context.Background())
 	assert.Len(t, queries, 1)
 	assert.False(t, queries[0].Success)
 
-	supplyData, ok := data.(SupplyData)
-	assert.True(t, ok)
 	assert.Empty(t, supplyData.Supplies)
 }
 
@@ -127,7 +123,7 @@ func TestSupplyFetcherNodeError(t *testing.T) {
 
 	httpmock.RegisterResponder(
 		"GET",
-		"https://api.cosmos.quokkastake.io/cosmos/bank/v1beta1/supply?pagination.limit=10000&pagination.offset=0",
+		"https://api.cosmos.quokkastake.io/cosmos/bank/v1beta1/supply?pagination.limit=1000",
 		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("error.json")),
 	)
 
@@ -150,12 +146,10 @@ func TestSupplyFetcherNodeError(t *testing.T) {
 		RPCs:   rpcs,
 		Tracer: tracing.InitNoopTracer(),
 	}
-	data, queries := fetcher.Fetch(context.Background())
+	supplyData, queries := fetcher.Fetch(context.Background())
 	assert.Len(t, queries, 1)
 	assert.False(t, queries[0].Success)
 
-	supplyData, ok := data.(SupplyData)
-	assert.True(t, ok)
 	assert.Empty(t, supplyData.Supplies)
 }
 
@@ -167,7 +161,7 @@ func TestSupplyFetcherQuerySuccess(t *testing.T) {
 
 	httpmock.RegisterResponder(
 		"GET",
-		"https://api.cosmos.quokkastake.io/cosmos/bank/v1beta1/supply?pagination.limit=10000&pagination.offset=0",
+		"https://api.cosmos.quokkastake.io/cosmos/bank/v1beta1/supply?pagination.limit=1000",
 		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("supply.json")),
 	)
 
@@ -190,13 +184,10 @@ func TestSupplyFetcherQuerySuccess(t *testing.T) {
 		RPCs:   rpcs,
 		Tracer: tracing.InitNoopTracer(),
 	}
-	data, queries := fetcher.Fetch(context.Background())
+	supplyData, queries := fetcher.Fetch(context.Background())
 	assert.Len(t, queries, 1)
 	assert.True(t, queries[0].Success)
 
-	supplyData, ok := data.(SupplyData)
-	assert.True(t, ok)
-
 	chainData, ok := supplyData.Supplies["chain"]
 	assert.True(t, ok)
 	assert.Len(t, chainData, 1)
@@ -212,7 +203,7 @@ func TestSupplyFetcherConsumer(t *testing.T) {
 
 	httpmock.RegisterResponder(
 		"GET",
-		"https://api.neutron.quokkastake.io/cosmos/bank/v1beta1/supply?pagination.limit=10000&pagination.offset=0",
+		"https://api.neutron.quokkastake.io/cosmos/bank/v1beta1/supply?pagination.limit=1000",
 		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("supply.json")),
 	)
 
@@ -242,13 +233,10 @@ func TestSupplyFetcherConsumer(t *testing.T) {
 		RPCs:   rpcs,
 		Tracer: tracing.InitNoopTracer(),
 	}
-	data, queries := fetcher.Fetch(context.Background())
+	supplyData, queries := fetcher.Fetch(context.Background())
 	assert.Len(t, queries, 1)
 	assert.True(t, queries[0].Success)
 
-	supplyData, ok := data.(SupplyData)
-	assert.True(t, ok)
-
 	chainData, ok := supplyData.Supplies["consumer"]
 	assert.True(t, ok)
 	assert.Len(t, chainData, 1)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"main/pkg/config"
 	"main/pkg/constants"
+	statePkg "main/pkg/state"
 	"main/pkg/tendermint"
 	"main/pkg/types"
 	"sync"
@@ -23,6 +24,8 @@ type UnbondsData struct {
 	Unbonds map[string]map[string]uint64
 }
 
+var UnbondsKey = statePkg.NewKey[UnbondsData](constants.FetcherNameUnbonds)
+
 func NewUnbondsFetcher(
 	logger *zerolog.Logger,
 	chains []*config.Chain,
@@ -43,7 +46,7 @@ func (q *UnbondsFetcher) Dependencies() []constants.FetcherName {
 func (q *UnbondsFetcher) Fetch(
 	ctx context.Context,
 	data ...any,
-) (any, []*types.QueryInfo) {
+) (UnbondsData, []*types.QueryInfo) {
 	var queryInfos []*types.QueryInfo
 
 	allUnbonds := map[string]map[string]uint64{}
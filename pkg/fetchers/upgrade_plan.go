@@ -0,0 +1,130 @@
+package fetchers
+
+import (
+	"context"
+	"main/pkg/config"
+	"main/pkg/constants"
+	statePkg "main/pkg/state"
+	"main/pkg/tendermint"
+	"main/pkg/types"
+	"sync"
+
+	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type UpgradePlanFetcher struct {
+	Logger zerolog.Logger
+	Chains []*config.Chain
+	RPCs   map[string]*tendermint.RPCWithConsumers
+	Tracer trace.Tracer
+}
+
+type UpgradePlanData struct {
+	// chain -> upgrade plan, nil for the chains that have no upgrade scheduled
+	Plans      map[string]*types.UpgradePlan
+	BlockTimes map[string]*types.BlockTime
+}
+
+var UpgradePlanKey = statePkg.NewKey[UpgradePlanData](constants.FetcherNameUpgradePlan)
+
+func NewUpgradePlanFetcher(
+	logger *zerolog.Logger,
+	chains []*config.Chain,
+	rpcs map[string]*tendermint.RPCWithConsumers,
+	tracer trace.Tracer,
+) *UpgradePlanFetcher {
+	return &UpgradePlanFetcher{
+		Logger: logger.With().Str("component", "upgrade_plan_fetcher").Logger(),
+		Chains: chains,
+		RPCs:   rpcs,
+		Tracer: tracer,
+	}
+}
+
+func (q *UpgradePlanFetcher) Dependencies() []constants.FetcherName {
+	return []constants.FetcherName{}
+}
+
+func (q *UpgradePlanFetcher) Fetch(
+	ctx context.Context,
+	data ...any,
+) (UpgradePlanData, []*types.QueryInfo) {
+	queryInfos := []*types.QueryInfo{}
+	allPlans := map[string]*types.UpgradePlan{}
+	allBlockTimes := map[string]*types.BlockTime{}
+
+	var (
+		wg    sync.WaitGroup
+		mutex sync.Mutex
+	)
+
+	processChain := func(chainName string, rpc *tendermint.RPC) {
+		defer wg.Done()
+
+		plan, query, err := rpc.GetUpgradePlan(ctx)
+
+		mutex.Lock()
+		if query != nil {
+			queryInfos = append(queryInfos, query)
+		}
+		mutex.Unlock()
+
+		if err != nil {
+			q.Logger.Error().
+				Err(err).
+				Str("chain", chainName).
+				Msg("Error querying upgrade plan")
+			return
+		}
+
+		if plan == nil {
+			mutex.Lock()
+			allPlans[chainName] = nil
+			mutex.Unlock()
+			return
+		}
+
+		blockTime, queries, err := rpc.GetBlockTime(ctx)
+
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		queryInfos = append(queryInfos, queries...)
+		allPlans[chainName] = plan
+
+		if err != nil {
+			q.Logger.Error().
+				Err(err).
+				Str("chain", chainName).
+				Msg("Error querying average block time")
+			return
+		}
+
+		if blockTime == nil {
+			return
+		}
+
+		allBlockTimes[chainName] = blockTime
+	}
+
+	for _, chain := range q.Chains {
+		rpc := q.RPCs[chain.Name]
+
+		wg.Add(1 + len(chain.ConsumerChains))
+
+		go processChain(chain.Name, rpc.RPC)
+
+		for consumerIndex, consumerChain := range chain.ConsumerChains {
+			go processChain(consumerChain.Name, rpc.Consumers[consumerIndex])
+		}
+	}
+
+	wg.Wait()
+
+	return UpgradePlanData{Plans: allPlans, BlockTimes: allBlockTimes}, queryInfos
+}
+
+func (q *UpgradePlanFetcher) Name() constants.FetcherName {
+	return constants.FetcherNameUpgradePlan
+}
@@ -0,0 +1,193 @@
+package fetchers
+
+import (
+	"context"
+	"errors"
+	"main/assets"
+	"main/pkg/config"
+	"main/pkg/constants"
+	"main/pkg/logger"
+	"main/pkg/tendermint"
+	"main/pkg/tracing"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpgradePlanFetcherBase(t *testing.T) {
+	t.Parallel()
+
+	chains := []*config.Chain{{Name: "chain", LCDEndpoint: "example"}}
+	rpcs := map[string]*tendermint.RPCWithConsumers{
+		"chain": tendermint.RPCWithConsumersFromChain(
+			chains[0],
+			10,
+			*logger.GetNopLogger(),
+			tracing.InitNoopTracer(),
+		),
+	}
+	fetcher := NewUpgradePlanFetcher(
+		logger.GetNopLogger(),
+		chains,
+		rpcs,
+		tracing.InitNoopTracer(),
+	)
+
+	assert.NotNil(t, fetcher)
+	assert.Equal(t, constants.FetcherNameUpgradePlan, fetcher.Name())
+	assert.Empty(t, fetcher.Dependencies())
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestUpgradePlanFetcherQueryError(t *testing.T) {
+	httpmock.Activate()
+
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://api.cosmos.quokkastake.io/cosmos/upgrade/v1beta1/current_plan",
+		httpmock.NewErrorResponder(errors.New("error")),
+	)
+
+	chains := []*config.Chain{{
+		Name:        "chain",
+		LCDEndpoint: "https://api.cosmos.quokkastake.io",
+	}}
+	rpcs := map[string]*tendermint.RPCWithConsumers{
+		"chain": tendermint.RPCWithConsumersFromChain(
+			chains[0],
+			10,
+			*logger.GetNopLogger(),
+			tracing.InitNoopTracer(),
+		),
+	}
+	fetcher := &UpgradePlanFetcher{
+		Logger: *logger.GetNopLogger(),
+		Chains: chains,
+		RPCs:   rpcs,
+		Tracer: tracing.InitNoopTracer(),
+	}
+	upgradeData, queries := fetcher.Fetch(context.Background())
+	assert.Len(t, queries, 1)
+	assert.False(t, queries[0].Success)
+
+	assert.Empty(t, upgradeData.Plans)
+	assert.Empty(t, upgradeData.BlockTimes)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestUpgradePlanFetcherBlockTimeError(t *testing.T) {
+	httpmock.Activate()
+
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://api.cosmos.quokkastake.io/cosmos/upgrade/v1beta1/current_plan",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("upgrade-plan.json")),
+	)
+	httpmock.RegisterResponder(
+		"GET",
+		"https://api.cosmos.quokkastake.io/cosmos/base/tendermint/v1beta1/blocks/latest",
+		httpmock.NewErrorResponder(errors.New("error")),
+	)
+
+	chains := []*config.Chain{{
+		Name:        "chain",
+		LCDEndpoint: "https://api.cosmos.quokkastake.io",
+	}}
+	rpcs := map[string]*tendermint.RPCWithConsumers{
+		"chain": tendermint.RPCWithConsumersFromChain(
+			chains[0],
+			10,
+			*logger.GetNopLogger(),
+			tracing.InitNoopTracer(),
+		),
+	}
+	fetcher := &UpgradePlanFetcher{
+		Logger: *logger.GetNopLogger(),
+		Chains: chains,
+		RPCs:   rpcs,
+		Tracer: tracing.InitNoopTracer(),
+	}
+	upgradeData, queries := fetcher.Fetch(context.Background())
+	assert.Len(t, queries, 2)
+
+	assert.Len(t, upgradeData.Plans, 1)
+	assert.Empty(t, upgradeData.BlockTimes)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestUpgradePlanFetcherQuerySuccess(t *testing.T) {
+	httpmock.Activate()
+
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://api.cosmos.quokkastake.io/cosmos/upgrade/v1beta1/current_plan",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("upgrade-plan.json")),
+	)
+	httpmock.RegisterResponder(
+		"GET",
+		"https://api.cosmos.quokkastake.io/cosmos/base/tendermint/v1beta1/blocks/latest",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("latest-block.json")),
+	)
+	httpmock.RegisterResponder(
+		"GET",
+		"https://api.cosmos.quokkastake.io/cosmos/base/tendermint/v1beta1/blocks/21999900",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("earlier-block.json")).
+			HeaderSet(http.Header{"Grpc-Metadata-X-Cosmos-Block-Height": []string{"21999900"}}),
+	)
+	httpmock.RegisterResponder(
+		"GET",
+		"https://api.neutron.quokkastake.io/cosmos/upgrade/v1beta1/current_plan",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("upgrade-plan-empty.json")),
+	)
+
+	chains := []*config.Chain{{
+		Name:        "chain",
+		LCDEndpoint: "https://api.cosmos.quokkastake.io",
+		ConsumerChains: []*config.ConsumerChain{{
+			Name:        "consumer",
+			LCDEndpoint: "https://api.neutron.quokkastake.io",
+		}},
+	}}
+	rpcs := map[string]*tendermint.RPCWithConsumers{
+		"chain": tendermint.RPCWithConsumersFromChain(
+			chains[0],
+			10,
+			*logger.GetNopLogger(),
+			tracing.InitNoopTracer(),
+		),
+	}
+	fetcher := &UpgradePlanFetcher{
+		Logger: *logger.GetNopLogger(),
+		Chains: chains,
+		RPCs:   rpcs,
+		Tracer: tracing.InitNoopTracer(),
+	}
+	upgradeData, queries := fetcher.Fetch(context.Background())
+	assert.Len(t, queries, 4)
+
+	assert.Len(t, upgradeData.Plans, 2)
+
+	consumerPlan, ok := upgradeData.Plans["consumer"]
+	assert.True(t, ok)
+	assert.Nil(t, consumerPlan)
+
+	plan, ok := upgradeData.Plans["chain"]
+	assert.True(t, ok)
+	require.NotNil(t, plan)
+	assert.Equal(t, "v22", plan.Name)
+
+	blockTime, ok := upgradeData.BlockTimes["chain"]
+	assert.True(t, ok)
+	require.NotNil(t, blockTime)
+	assert.Equal(t, 6200*time.Millisecond, blockTime.AverageBlockTime)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"main/pkg/config"
 	"main/pkg/constants"
+	statePkg "main/pkg/state"
 	"main/pkg/tendermint"
 	"main/pkg/types"
 	"sync"
@@ -23,6 +24,8 @@ type ValidatorsData struct {
 	Validators map[string]*types.ValidatorsResponse
 }
 
+var ValidatorsKey = statePkg.NewKey[ValidatorsData](constants.FetcherNameValidators)
+
 func NewValidatorsFetcher(
 	logger *zerolog.Logger,
 	chains []*config.Chain,
@@ -44,7 +47,7 @@ func (q *ValidatorsFetcher) Dependencies() []constants.FetcherName {
 func (f *ValidatorsFetcher) Fetch(
 	ctx context.Context,
 	data ...any,
-) (any, []*types.QueryInfo) {
+) (ValidatorsData, []*types.QueryInfo) {
 	var queryInfos []*types.QueryInfo
 
 	allValidators := map[string]*types.ValidatorsResponse{}
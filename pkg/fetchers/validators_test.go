@@ -72,12 +72,9 @@ func TestValidatorsFetcherQueryDisabled(t *testing.T) {
 		RPCs:   rpcs,
 		Tracer: tracing.InitNoopTracer(),
 	}
-	data, queries := fetcher.Fetch(context.Background())
+	validatorsData, queries := fetcher.Fetch(context.Background())
 	assert.Empty(t, queries)
 
-	validatorsData, ok := data.(ValidatorsData)
-	assert.True(t, ok)
-
 	chainData, ok := validatorsData.Validators["chain"]
 	assert.True(t, ok)
 	assert.Empty(t, chainData)
@@ -91,7 +88,7 @@ func TestValidatorsFetcherQueryError(t *testing.T) {
 
 	httpmock.RegisterResponder(
 		"GET",
-		"https://api.cosmos.quokkastake.io/cosmos/staking/v1beta1/validators?pagination.count_total=true&pagination.limit=10000",
+		"https://api.cosmos.quokkastake.io/cosmos/staking/v1beta1/validators?pagination.limit=1000",
 		httpmock.NewErrorResponder(errors.New("error")),
 	)
 
@@ -115,12 +112,10 @@ func TestValidatorsFetcherQueryError(t *testing.T) {
 		RPCs:   rpcs,
 		Tracer: tracing.InitNoopTracer(),
 	}
-	data, queries := fetcher.Fetch(context.Background())
+	validatorsData, queries := fetcher.Fetch(context.Background())
 	assert.Len(t, queries, 1)
 	assert.False(t, queries[0].Success)
 
-	validatorsData, ok := data.(ValidatorsData)
-	assert.True(t, ok)
 	assert.Empty(t, validatorsData.Validators)
 }
 
@@ -132,7 +127,7 @@ func TestValidatorsFetcherNodeError(t *testing.T) {
 
 	httpmock.RegisterResponder(
 		"GET",
-		"https://api.cosmos.quokkastake.io/cosmos/staking/v1beta1/validators?pagination.count_total=true&pagination.limit=10000",
+		"https://api.cosmos.quokkastake.io/cosmos/staking/v1beta1/validators?pagination.limit=1000",
 		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("error.json")),
 	)
 
@@ -156,12 +151,10 @@ func TestValidatorsFetcherNodeError(t *testing.T) {
 		RPCs:   rpcs,
 		Tracer: tracing.InitNoopTracer(),
 	}
-	data, queries := fetcher.Fetch(context.Background())
+	validatorsData, queries := fetcher.Fetch(context.Background())
 	assert.Len(t, queries, 1)
 	assert.False(t, queries[0].Success)
 
-	validatorsData, ok := data.(ValidatorsData)
-	assert.True(t, ok)
 	assert.Empty(t, validatorsData.Validators)
 }
 
@@ -173,7 +166,7 @@ func TestValidatorsFetcherQuerySuccess(t *testing.T) {
 
 	httpmock.RegisterResponder(
 		"GET",
-		"https://api.cosmos.quokkastake.io/cosmos/staking/v1beta1/validators?pagination.count_total=true&pagination.limit=10000",
+		"https://api.cosmos.quokkastake.io/cosmos/staking/v1beta1/validators?pagination.limit=1000",
 		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("validators.json")),
 	)
 
@@ -197,13 +190,10 @@ func TestValidatorsFetcherQuerySuccess(t *testing.T) {
 		RPCs:   rpcs,
 		Tracer: tracing.InitNoopTracer(),
 	}
-	data, queries := fetcher.Fetch(context.Background())
+	validatorsData, queries := fetcher.Fetch(context.Background())
 	assert.Len(t, queries, 1)
 	assert.True(t, queries[0].Success)
 
-	validatorsData, ok := data.(ValidatorsData)
-	assert.True(t, ok)
-
 	chainData, ok := validatorsData.Validators["chain"]
 	assert.True(t, ok)
 	assert.Len(t, chainData.Validators, 542)
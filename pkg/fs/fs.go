@@ -2,4 +2,5 @@ package fs
 
 type FS interface {
 	ReadFile(name string) ([]byte, error)
+	WriteFile(name string, data []byte) error
 }
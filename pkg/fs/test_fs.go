@@ -2,10 +2,34 @@ package fs
 
 import (
 	"main/assets"
+	"sync"
 )
 
-type TestFS struct{}
+type TestFS struct {
+	mutex   sync.Mutex
+	written map[string][]byte
+}
 
 func (fs *TestFS) ReadFile(name string) ([]byte, error) {
+	fs.mutex.Lock()
+	defer fs.mutex.Unlock()
+
+	if content, ok := fs.written[name]; ok {
+		return content, nil
+	}
+
 	return assets.EmbedFS.ReadFile(name)
 }
+
+func (fs *TestFS) WriteFile(name string, data []byte) error {
+	fs.mutex.Lock()
+	defer fs.mutex.Unlock()
+
+	if fs.written == nil {
+		fs.written = map[string][]byte{}
+	}
+
+	fs.written[name] = data
+
+	return nil
+}
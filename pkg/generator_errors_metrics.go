@@ -0,0 +1,39 @@
+package pkg
+
+import (
+	"main/pkg/constants"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type GeneratorErrorsMetrics struct {
+	ErrorsCounter *prometheus.CounterVec
+}
+
+func NewGeneratorErrorsMetrics(generatorNames []constants.GeneratorName) *GeneratorErrorsMetrics {
+	errorsCounter := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: constants.MetricsPrefix + "generator_errors_total",
+			Help: "Total errors that happened while generating metrics, per generator",
+		},
+		[]string{"generator"},
+	)
+
+	for _, generatorName := range generatorNames {
+		errorsCounter.With(prometheus.Labels{
+			"generator": string(generatorName),
+		}).Add(0)
+	}
+
+	return &GeneratorErrorsMetrics{ErrorsCounter: errorsCounter}
+}
+
+func (m *GeneratorErrorsMetrics) Inc(generatorName constants.GeneratorName) {
+	m.ErrorsCounter.With(prometheus.Labels{
+		"generator": string(generatorName),
+	}).Inc()
+}
+
+func (m *GeneratorErrorsMetrics) GetMetrics() []prometheus.Collector {
+	return []prometheus.Collector{m.ErrorsCounter}
+}
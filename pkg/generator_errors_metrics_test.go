@@ -0,0 +1,34 @@
+package pkg
+
+import (
+	"main/pkg/constants"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGeneratorErrorsMetrics(t *testing.T) {
+	t.Parallel()
+
+	generator := NewGeneratorErrorsMetrics([]constants.GeneratorName{
+		constants.GeneratorNameUptime,
+		constants.GeneratorNamePrice,
+	})
+	generator.Inc(constants.GeneratorNamePrice)
+	generator.Inc(constants.GeneratorNamePrice)
+
+	metrics := generator.GetMetrics()
+	assert.Len(t, metrics, 1)
+
+	errorsCounter, ok := metrics[0].(*prometheus.CounterVec)
+	assert.True(t, ok)
+	assert.Equal(t, 2, testutil.CollectAndCount(errorsCounter))
+	assert.Zero(t, testutil.ToFloat64(errorsCounter.With(prometheus.Labels{
+		"generator": "uptime",
+	})))
+	assert.InDelta(t, 2, testutil.ToFloat64(errorsCounter.With(prometheus.Labels{
+		"generator": "price",
+	})), 0.01)
+}
@@ -20,13 +20,24 @@ func NewActiveSetTokensGenerator(chains []*configPkg.Chain) *ActiveSetTokensGene
 	return &ActiveSetTokensGenerator{Chains: chains}
 }
 
+func (g *ActiveSetTokensGenerator) Name() constants.GeneratorName {
+	return constants.GeneratorNameActiveSetTokens
+}
+
+func (g *ActiveSetTokensGenerator) Fetchers() []constants.FetcherName {
+	return []constants.FetcherName{
+		constants.FetcherNameValidators,
+		constants.FetcherNameStakingParams,
+	}
+}
+
 func (g *ActiveSetTokensGenerator) Generate(state *statePkg.State) []prometheus.Collector {
-	validators, ok := statePkg.StateGet[fetchersPkg.ValidatorsData](state, constants.FetcherNameValidators)
+	validators, ok := statePkg.Get(state, fetchersPkg.ValidatorsKey)
 	if !ok {
 		return []prometheus.Collector{}
 	}
 
-	stakingParams, ok := statePkg.StateGet[fetchersPkg.StakingParamsData](state, constants.FetcherNameStakingParams)
+	stakingParams, ok := statePkg.Get(state, fetchersPkg.StakingParamsKey)
 	if !ok {
 		return []prometheus.Collector{}
 	}
@@ -31,7 +31,7 @@ func TestActiveSetTokensGeneratorNoStakingParams(t *testing.T) {
 	t.Parallel()
 
 	state := statePkg.NewState()
-	state.Set(constants.FetcherNameValidators, fetchers.ValidatorsData{})
+	statePkg.Set(state, fetchers.ValidatorsKey, fetchers.ValidatorsData{})
 
 	generator := NewActiveSetTokensGenerator([]*config.Chain{})
 	results := generator.Generate(state)
@@ -43,8 +43,8 @@ func TestActiveSetTokensGeneratorNoChainValidators(t *testing.T) {
 
 	chains := []*config.Chain{{Name: "chain"}}
 	state := statePkg.NewState()
-	state.Set(constants.FetcherNameValidators, fetchers.ValidatorsData{})
-	state.Set(constants.FetcherNameStakingParams, fetchers.StakingParamsData{})
+	statePkg.Set(state, fetchers.ValidatorsKey, fetchers.ValidatorsData{})
+	statePkg.Set(state, fetchers.StakingParamsKey, fetchers.StakingParamsData{})
 
 	generator := NewActiveSetTokensGenerator(chains)
 	results := generator.Generate(state)
@@ -60,12 +60,12 @@ func TestActiveSetTokensGeneratorNoChainStakingParams(t *testing.T) {
 
 	chains := []*config.Chain{{Name: "chain"}}
 	state := statePkg.NewState()
-	state.Set(constants.FetcherNameValidators, fetchers.ValidatorsData{
+	statePkg.Set(state, fetchers.ValidatorsKey, fetchers.ValidatorsData{
 		Validators: map[string]*types.ValidatorsResponse{
 			"chain": {},
 		},
 	})
-	state.Set(constants.FetcherNameStakingParams, fetchers.StakingParamsData{})
+	statePkg.Set(state, fetchers.StakingParamsKey, fetchers.StakingParamsData{})
 
 	generator := NewActiveSetTokensGenerator(chains)
 	results := generator.Generate(state)
@@ -85,7 +85,7 @@ func TestActiveSetTokensGeneratorNotEnoughValidators(t *testing.T) {
 		Denoms:    config.DenomInfos{{Denom: "uatom", DisplayDenom: "atom", DenomExponent: 6}},
 	}}
 	state := statePkg.NewState()
-	state.Set(constants.FetcherNameValidators, fetchers.ValidatorsData{
+	statePkg.Set(state, fetchers.ValidatorsKey, fetchers.ValidatorsData{
 		Validators: map[string]*types.ValidatorsResponse{
 			"chain": {
 				Validators: []types.Validator{
@@ -96,7 +96,7 @@ func TestActiveSetTokensGeneratorNotEnoughValidators(t *testing.T) {
 			},
 		},
 	})
-	state.Set(constants.FetcherNameStakingParams, fetchers.StakingParamsData{
+	statePkg.Set(state, fetchers.StakingParamsKey, fetchers.StakingParamsData{
 		Params: map[string]*types.StakingParamsResponse{
 			"chain": {
 				StakingParams: types.StakingParams{MaxValidators: 100},
@@ -125,7 +125,7 @@ func TestActiveSetTokensGeneratorEnoughValidators(t *testing.T) {
 		Denoms:    config.DenomInfos{{Denom: "uatom", DisplayDenom: "atom", DenomExponent: 6}},
 	}}
 	state := statePkg.NewState()
-	state.Set(constants.FetcherNameValidators, fetchers.ValidatorsData{
+	statePkg.Set(state, fetchers.ValidatorsKey, fetchers.ValidatorsData{
 		Validators: map[string]*types.ValidatorsResponse{
 			"chain": {
 				Validators: []types.Validator{
@@ -136,7 +136,7 @@ func TestActiveSetTokensGeneratorEnoughValidators(t *testing.T) {
 			},
 		},
 	})
-	state.Set(constants.FetcherNameStakingParams, fetchers.StakingParamsData{
+	statePkg.Set(state, fetchers.StakingParamsKey, fetchers.StakingParamsData{
 		Params: map[string]*types.StakingParamsResponse{
 			"chain": {
 				StakingParams: types.StakingParams{MaxValidators: 2},
@@ -165,7 +165,7 @@ func TestActiveSetTokensGeneratorDenomIgnored(t *testing.T) {
 		Denoms:    config.DenomInfos{{Denom: "uatom", Ignore: null.BoolFrom(true)}},
 	}}
 	state := statePkg.NewState()
-	state.Set(constants.FetcherNameValidators, fetchers.ValidatorsData{
+	statePkg.Set(state, fetchers.ValidatorsKey, fetchers.ValidatorsData{
 		Validators: map[string]*types.ValidatorsResponse{
 			"chain": {
 				Validators: []types.Validator{
@@ -176,7 +176,7 @@ func TestActiveSetTokensGeneratorDenomIgnored(t *testing.T) {
 			},
 		},
 	})
-	state.Set(constants.FetcherNameStakingParams, fetchers.StakingParamsData{
+	statePkg.Set(state, fetchers.StakingParamsKey, fetchers.StakingParamsData{
 		Params: map[string]*types.StakingParamsResponse{
 			"chain": {
 				StakingParams: types.StakingParams{MaxValidators: 2},
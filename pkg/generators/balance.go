@@ -17,8 +17,16 @@ func NewBalanceGenerator(chains []*config.Chain) *BalanceGenerator {
 	return &BalanceGenerator{Chains: chains}
 }
 
+func (g *BalanceGenerator) Name() constants.GeneratorName {
+	return constants.GeneratorNameBalance
+}
+
+func (g *BalanceGenerator) Fetchers() []constants.FetcherName {
+	return []constants.FetcherName{constants.FetcherNameBalance}
+}
+
 func (g *BalanceGenerator) Generate(state *statePkg.State) []prometheus.Collector {
-	data, ok := statePkg.StateGet[fetchersPkg.BalanceData](state, constants.FetcherNameBalance)
+	data, ok := statePkg.Get(state, fetchersPkg.BalanceKey)
 	if !ok {
 		return []prometheus.Collector{}
 	}
@@ -17,8 +17,16 @@ func NewCommissionGenerator(chains []*config.Chain) *CommissionGenerator {
 	return &CommissionGenerator{Chains: chains}
 }
 
+func (g *CommissionGenerator) Name() constants.GeneratorName {
+	return constants.GeneratorNameCommission
+}
+
+func (g *CommissionGenerator) Fetchers() []constants.FetcherName {
+	return []constants.FetcherName{constants.FetcherNameCommission}
+}
+
 func (g *CommissionGenerator) Generate(state *statePkg.State) []prometheus.Collector {
-	data, ok := statePkg.StateGet[fetchersPkg.CommissionData](state, constants.FetcherNameCommission)
+	data, ok := statePkg.Get(state, fetchersPkg.CommissionKey)
 	if !ok {
 		return []prometheus.Collector{}
 	}
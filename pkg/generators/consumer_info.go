@@ -19,8 +19,16 @@ func NewConsumerInfoGenerator(chains []*config.Chain) *ConsumerInfoGenerator {
 	return &ConsumerInfoGenerator{Chains: chains}
 }
 
+func (g *ConsumerInfoGenerator) Name() constants.GeneratorName {
+	return constants.GeneratorNameConsumerInfo
+}
+
+func (g *ConsumerInfoGenerator) Fetchers() []constants.FetcherName {
+	return []constants.FetcherName{constants.FetcherNameConsumerInfo}
+}
+
 func (g *ConsumerInfoGenerator) Generate(state *statePkg.State) []prometheus.Collector {
-	consumerInfos, ok := statePkg.StateGet[fetchersPkg.ConsumerInfoData](state, constants.FetcherNameConsumerInfo)
+	consumerInfos, ok := statePkg.Get(state, fetchersPkg.ConsumerInfoKey)
 	if !ok {
 		return []prometheus.Collector{}
 	}
@@ -2,7 +2,6 @@ package generators
 
 import (
 	"main/pkg/config"
-	"main/pkg/constants"
 	"main/pkg/fetchers"
 	statePkg "main/pkg/state"
 	"main/pkg/types"
@@ -29,7 +28,7 @@ func TestConsumerInfoGeneratorNotEmptyState(t *testing.T) {
 	t.Parallel()
 
 	state := statePkg.NewState()
-	state.Set(constants.FetcherNameConsumerInfo, fetchers.ConsumerInfoData{
+	statePkg.Set(state, fetchers.ConsumerInfoKey, fetchers.ConsumerInfoData{
 		Info: map[string]map[string]types.ConsumerChainInfo{
 			"provider": {
 				"0": types.ConsumerChainInfo{
@@ -18,13 +18,24 @@ func NewConsumerNeedsToSignGenerator(chains []*config.Chain) *ConsumerNeedsToSig
 	return &ConsumerNeedsToSignGenerator{Chains: chains}
 }
 
+func (g *ConsumerNeedsToSignGenerator) Name() constants.GeneratorName {
+	return constants.GeneratorNameConsumerNeedsToSign
+}
+
+func (g *ConsumerNeedsToSignGenerator) Fetchers() []constants.FetcherName {
+	return []constants.FetcherName{
+		constants.FetcherNameValidatorConsumers,
+		constants.FetcherNameConsumerInfo,
+	}
+}
+
 func (g *ConsumerNeedsToSignGenerator) Generate(state *statePkg.State) []prometheus.Collector {
-	allValidatorsConsumers, ok := statePkg.StateGet[fetchersPkg.ValidatorConsumersData](state, constants.FetcherNameValidatorConsumers)
+	allValidatorsConsumers, ok := statePkg.Get(state, fetchersPkg.ValidatorConsumersKey)
 	if !ok {
 		return []prometheus.Collector{}
 	}
 
-	consumerInfos, ok := statePkg.StateGet[fetchersPkg.ConsumerInfoData](state, constants.FetcherNameConsumerInfo)
+	consumerInfos, ok := statePkg.Get(state, fetchersPkg.ConsumerInfoKey)
 	if !ok {
 		return []prometheus.Collector{}
 	}
@@ -2,7 +2,6 @@ package generators
 
 import (
 	"main/pkg/config"
-	"main/pkg/constants"
 	"main/pkg/fetchers"
 	statePkg "main/pkg/state"
 	"main/pkg/types"
@@ -27,7 +26,7 @@ func TestConsumerNeedsToSignGeneratorNoConsumerInfo(t *testing.T) {
 	t.Parallel()
 
 	state := statePkg.NewState()
-	state.Set(constants.FetcherNameValidatorConsumers, fetchers.ValidatorConsumersData{
+	statePkg.Set(state, fetchers.ValidatorConsumersKey, fetchers.ValidatorConsumersData{
 		Infos: map[string]map[string]map[string]bool{
 			"provider": {
 				"validator": map[string]bool{
@@ -46,7 +45,7 @@ func TestConsumerNeedsToSignGeneratorNotEmptyState(t *testing.T) {
 	t.Parallel()
 
 	state := statePkg.NewState()
-	state.Set(constants.FetcherNameValidatorConsumers, fetchers.ValidatorConsumersData{
+	statePkg.Set(state, fetchers.ValidatorConsumersKey, fetchers.ValidatorConsumersData{
 		Infos: map[string]map[string]map[string]bool{
 			"provider": {
 				"validator": map[string]bool{
@@ -56,7 +55,7 @@ func TestConsumerNeedsToSignGeneratorNotEmptyState(t *testing.T) {
 		},
 	})
 
-	state.Set(constants.FetcherNameConsumerInfo, fetchers.ConsumerInfoData{
+	statePkg.Set(state, fetchers.ConsumerInfoKey, fetchers.ConsumerInfoData{
 		Info: map[string]map[string]types.ConsumerChainInfo{
 			"provider": {
 				"consumer-id": types.ConsumerChainInfo{
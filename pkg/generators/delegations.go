@@ -15,8 +15,16 @@ func NewDelegationsGenerator() *DelegationsGenerator {
 	return &DelegationsGenerator{}
 }
 
+func (g *DelegationsGenerator) Name() constants.GeneratorName {
+	return constants.GeneratorNameDelegations
+}
+
+func (g *DelegationsGenerator) Fetchers() []constants.FetcherName {
+	return []constants.FetcherName{constants.FetcherNameDelegations}
+}
+
 func (g *DelegationsGenerator) Generate(state *statePkg.State) []prometheus.Collector {
-	data, ok := statePkg.StateGet[fetchersPkg.DelegationsData](state, constants.FetcherNameDelegations)
+	data, ok := statePkg.Get(state, fetchersPkg.DelegationsKey)
 	if !ok {
 		return []prometheus.Collector{}
 	}
@@ -1,7 +1,6 @@
 package generators
 
 import (
-	"main/pkg/constants"
 	"main/pkg/fetchers"
 	statePkg "main/pkg/state"
 	"testing"
@@ -25,7 +24,7 @@ func TestDelegationsGeneratorNotEmptyState(t *testing.T) {
 	t.Parallel()
 
 	state := statePkg.NewState()
-	state.Set(constants.FetcherNameDelegations, fetchers.DelegationsData{
+	statePkg.Set(state, fetchers.DelegationsKey, fetchers.DelegationsData{
 		Delegations: map[string]map[string]uint64{
 			"chain": {
 				"validator": 100,
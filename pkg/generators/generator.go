@@ -1,11 +1,53 @@
 package generators
 
 import (
+	"main/pkg/constants"
 	statePkg "main/pkg/state"
+	"slices"
 
 	"github.com/prometheus/client_golang/prometheus"
 )
 
 type Generator interface {
 	Generate(state *statePkg.State) []prometheus.Collector
+	Name() constants.GeneratorName
+	Fetchers() []constants.FetcherName
+}
+
+type Generators []Generator
+
+func (g Generators) GetNames() []constants.GeneratorName {
+	names := make([]constants.GeneratorName, len(g))
+
+	for index, generator := range g {
+		names[index] = generator.Name()
+	}
+
+	return names
+}
+
+func (g Generators) GetFetchers() []constants.FetcherName {
+	fetcherNames := []constants.FetcherName{}
+
+	for _, generator := range g {
+		for _, fetcherName := range generator.Fetchers() {
+			if !slices.Contains(fetcherNames, fetcherName) {
+				fetcherNames = append(fetcherNames, fetcherName)
+			}
+		}
+	}
+
+	return fetcherNames
+}
+
+func (g Generators) WithoutDisabled(disabled []constants.GeneratorName) Generators {
+	enabled := Generators{}
+
+	for _, generator := range g {
+		if !slices.Contains(disabled, generator.Name()) {
+			enabled = append(enabled, generator)
+		}
+	}
+
+	return enabled
 }
@@ -0,0 +1,43 @@
+package generators
+
+import (
+	"main/pkg/constants"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGeneratorsGetFetchers(t *testing.T) {
+	t.Parallel()
+
+	generators := Generators{
+		NewUptimeGenerator(),
+		NewValidatorsInfoGenerator(nil),
+		NewActiveSetTokensGenerator(nil),
+	}
+
+	assert.Equal(t, []constants.FetcherName{
+		constants.FetcherNameValidators,
+		constants.FetcherNameConsumerValidators,
+		constants.FetcherNameStakingParams,
+	}, generators.GetFetchers())
+}
+
+func TestGeneratorsWithoutDisabled(t *testing.T) {
+	t.Parallel()
+
+	generators := Generators{
+		NewUptimeGenerator(),
+		NewPriceGenerator(),
+		NewInflationGenerator(),
+	}
+
+	enabled := generators.WithoutDisabled([]constants.GeneratorName{
+		constants.GeneratorNamePrice,
+		"nonexistent",
+	})
+	assert.Equal(t, []constants.GeneratorName{
+		constants.GeneratorNameUptime,
+		constants.GeneratorNameInflation,
+	}, enabled.GetNames())
+}
@@ -0,0 +1,137 @@
+package generators
+
+import (
+	"main/pkg/constants"
+	fetchersPkg "main/pkg/fetchers"
+	statePkg "main/pkg/state"
+	"main/pkg/utils"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type GovernanceGenerator struct {
+}
+
+func NewGovernanceGenerator() *GovernanceGenerator {
+	return &GovernanceGenerator{}
+}
+
+func (g *GovernanceGenerator) Name() constants.GeneratorName {
+	return constants.GeneratorNameGovernance
+}
+
+func (g *GovernanceGenerator) Fetchers() []constants.FetcherName {
+	return []constants.FetcherName{constants.FetcherNameGovernance}
+}
+
+func (g *GovernanceGenerator) Generate(state *statePkg.State) []prometheus.Collector {
+	data, ok := statePkg.Get(state, fetchersPkg.GovernanceKey)
+	if !ok {
+		return []prometheus.Collector{}
+	}
+
+	proposalVotingEndTimeGauge := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: constants.MetricsPrefix + "proposal_voting_end_time",
+			Help: "Voting end time of a proposal in the voting period, as a unix timestamp",
+		},
+		[]string{"chain", "proposal_id", "title"},
+	)
+
+	proposalTallyGauge := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: constants.MetricsPrefix + "proposal_tally_ratio",
+			Help: "Share of the voting power voted for each option on a proposal (0 to 1)",
+		},
+		[]string{"chain", "proposal_id", "option"},
+	)
+
+	validatorVotedGauge := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: constants.MetricsPrefix + "proposal_voted",
+			Help: "Whether the validator has voted on a proposal (1 if yes, 0 if no)",
+		},
+		[]string{"chain", "address", "proposal_id"},
+	)
+
+	validatorVoteGauge := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: constants.MetricsPrefix + "proposal_vote",
+			Help: "Weight of each option the validator has voted for on a proposal",
+		},
+		[]string{"chain", "address", "proposal_id", "option"},
+	)
+
+	for chain, proposals := range data.Proposals {
+		for _, proposal := range proposals {
+			proposalVotingEndTimeGauge.With(prometheus.Labels{
+				"chain":       chain,
+				"proposal_id": proposal.ID,
+				"title":       proposal.Title,
+			}).Set(float64(proposal.VotingEndTime.Unix()))
+
+			if proposal.Tally == nil {
+				continue
+			}
+
+			total := proposal.Tally.Total()
+			if total == 0 {
+				continue
+			}
+
+			for option, value := range map[string]float64{
+				"yes":          proposal.Tally.Yes,
+				"abstain":      proposal.Tally.Abstain,
+				"no":           proposal.Tally.No,
+				"no_with_veto": proposal.Tally.NoWithVeto,
+			} {
+				proposalTallyGauge.With(prometheus.Labels{
+					"chain":       chain,
+					"proposal_id": proposal.ID,
+					"option":      option,
+				}).Set(value / total)
+			}
+		}
+	}
+
+	for chain, proposals := range data.Proposals {
+		for validator, votes := range data.Votes[chain] {
+			for _, proposal := range proposals {
+				vote, found := votes[proposal.ID]
+				if !found {
+					continue
+				}
+
+				validatorVotedGauge.With(prometheus.Labels{
+					"chain":       chain,
+					"address":     validator,
+					"proposal_id": proposal.ID,
+				}).Set(utils.BoolToFloat64(vote != nil))
+
+				if vote == nil {
+					continue
+				}
+
+				for _, option := range vote.Options {
+					if option.Weight.IsNil() {
+						continue
+					}
+
+					validatorVoteGauge.With(prometheus.Labels{
+						"chain":       chain,
+						"address":     validator,
+						"proposal_id": proposal.ID,
+						"option":      option.GetOption(),
+					}).Set(option.Weight.MustFloat64())
+				}
+			}
+		}
+	}
+
+	return []prometheus.Collector{
+		proposalVotingEndTimeGauge,
+		proposalTallyGauge,
+		validatorVotedGauge,
+		validatorVoteGauge,
+	}
+}
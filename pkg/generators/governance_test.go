@@ -0,0 +1,128 @@
+package generators
+
+import (
+	"main/pkg/fetchers"
+	statePkg "main/pkg/state"
+	"main/pkg/types"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGovernanceGeneratorNoState(t *testing.T) {
+	t.Parallel()
+
+	state := statePkg.NewState()
+	generator := NewGovernanceGenerator()
+	results := generator.Generate(state)
+	assert.Empty(t, results)
+}
+
+func TestGovernanceGeneratorNotEmptyState(t *testing.T) {
+	t.Parallel()
+
+	state := statePkg.NewState()
+	statePkg.Set(state, fetchers.GovernanceKey, fetchers.GovernanceData{
+		Proposals: map[string][]types.GovProposal{
+			"chain": {
+				{
+					ID:            "1",
+					Title:         "Proposal",
+					VotingEndTime: time.Unix(1792843200, 0),
+					Tally:         &types.GovTally{Yes: 600, Abstain: 100, No: 200, NoWithVeto: 100},
+				},
+				{
+					ID:            "2",
+					Title:         "Proposal without tally",
+					VotingEndTime: time.Unix(1792843200, 0),
+				},
+			},
+			"consumer": {
+				{
+					ID:            "3",
+					Title:         "Proposal without votes",
+					VotingEndTime: time.Unix(1792843200, 0),
+					Tally:         &types.GovTally{},
+				},
+			},
+		},
+		Votes: map[string]map[string]map[string]*types.GovVote{
+			"chain": {
+				"validator": {
+					"1": {Options: []types.GovWeightedVoteOption{
+						{Option: "VOTE_OPTION_YES", Weight: math.LegacyMustNewDecFromStr("0.7")},
+						{Option: "VOTE_OPTION_NO_WITH_VETO", Weight: math.LegacyMustNewDecFromStr("0.3")},
+					}},
+					"2": nil,
+					// the proposal has ended, so it's not in the proposals list anymore
+					"4": {Options: []types.GovWeightedVoteOption{
+						{Option: "VOTE_OPTION_NO", Weight: math.LegacyMustNewDecFromStr("1")},
+					}},
+				},
+			},
+		},
+	})
+
+	generator := NewGovernanceGenerator()
+	results := generator.Generate(state)
+	assert.Len(t, results, 4)
+
+	votingEndTime, ok := results[0].(*prometheus.GaugeVec)
+	assert.True(t, ok)
+	assert.Equal(t, 3, testutil.CollectAndCount(votingEndTime))
+	assert.InDelta(t, 1792843200, testutil.ToFloat64(votingEndTime.With(prometheus.Labels{
+		"chain":       "chain",
+		"proposal_id": "1",
+		"title":       "Proposal",
+	})), 0.01)
+
+	tally, ok := results[1].(*prometheus.GaugeVec)
+	assert.True(t, ok)
+	assert.Equal(t, 4, testutil.CollectAndCount(tally))
+	assert.InDelta(t, 0.6, testutil.ToFloat64(tally.With(prometheus.Labels{
+		"chain":       "chain",
+		"proposal_id": "1",
+		"option":      "yes",
+	})), 0.01)
+	assert.InDelta(t, 0.1, testutil.ToFloat64(tally.With(prometheus.Labels{
+		"chain":       "chain",
+		"proposal_id": "1",
+		"option":      "no_with_veto",
+	})), 0.01)
+
+	voted, ok := results[2].(*prometheus.GaugeVec)
+	assert.True(t, ok)
+	assert.Equal(t, 2, testutil.CollectAndCount(voted))
+	assert.InDelta(t, 1, testutil.ToFloat64(voted.With(prometheus.Labels{
+		"chain":       "chain",
+		"address":     "validator",
+		"proposal_id": "1",
+	})), 0.01)
+	assert.Zero(t, testutil.ToFloat64(voted.With(prometheus.Labels{
+		"chain":       "chain",
+		"address":     "validator",
+		"proposal_id": "2",
+	})))
+
+	vote, ok := results[3].(*prometheus.GaugeVec)
+	assert.True(t, ok)
+	assert.Equal(t, 2, testutil.CollectAndCount(vote))
+	assert.InDelta(t, 0.7, testutil.ToFloat64(vote.With(prometheus.Labels{
+		"chain":       "chain",
+		"address":     "validator",
+		"proposal_id": "1",
+		"option":      "yes",
+	})), 0.01)
+	assert.InDelta(t, 0.3, testutil.ToFloat64(vote.With(prometheus.Labels{
+		"chain":       "chain",
+		"address":     "validator",
+		"proposal_id": "1",
+		"option":      "no_with_veto",
+	})), 0.01)
+}
@@ -15,8 +15,16 @@ func NewInflationGenerator() *InflationGenerator {
 	return &InflationGenerator{}
 }
 
+func (g *InflationGenerator) Name() constants.GeneratorName {
+	return constants.GeneratorNameInflation
+}
+
+func (g *InflationGenerator) Fetchers() []constants.FetcherName {
+	return []constants.FetcherName{constants.FetcherNameInflation}
+}
+
 func (g *InflationGenerator) Generate(state *statePkg.State) []prometheus.Collector {
-	data, ok := statePkg.StateGet[fetchersPkg.InflationData](state, constants.FetcherNameInflation)
+	data, ok := statePkg.Get(state, fetchersPkg.InflationKey)
 	if !ok {
 		return []prometheus.Collector{}
 	}
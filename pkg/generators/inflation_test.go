@@ -1,7 +1,6 @@
 package generators
 
 import (
-	"main/pkg/constants"
 	"main/pkg/fetchers"
 	statePkg "main/pkg/state"
 	"testing"
@@ -27,7 +26,7 @@ func TestInflationGeneratorNotEmptyState(t *testing.T) {
 	t.Parallel()
 
 	state := statePkg.NewState()
-	state.Set(constants.FetcherNameInflation, fetchers.InflationData{
+	statePkg.Set(state, fetchers.InflationKey, fetchers.InflationData{
 		Inflation: map[string]math.LegacyDec{
 			"chain": math.LegacyMustNewDecFromStr("0.1"),
 		},
@@ -16,6 +16,14 @@ func NewIsConsumerGenerator(chains []*config.Chain) *IsConsumerGenerator {
 	return &IsConsumerGenerator{Chains: chains}
 }
 
+func (g *IsConsumerGenerator) Name() constants.GeneratorName {
+	return constants.GeneratorNameIsConsumer
+}
+
+func (g *IsConsumerGenerator) Fetchers() []constants.FetcherName {
+	return []constants.FetcherName{}
+}
+
 func (g *IsConsumerGenerator) Generate(state *statePkg.State) []prometheus.Collector {
 	isConsumerGauge := prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
@@ -0,0 +1,123 @@
+package generators
+
+import (
+	"main/pkg/constants"
+	fetchersPkg "main/pkg/fetchers"
+	statePkg "main/pkg/state"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type JailRiskGenerator struct {
+}
+
+func NewJailRiskGenerator() *JailRiskGenerator {
+	return &JailRiskGenerator{}
+}
+
+func (g *JailRiskGenerator) Name() constants.GeneratorName {
+	return constants.GeneratorNameJailRisk
+}
+
+func (g *JailRiskGenerator) Fetchers() []constants.FetcherName {
+	return []constants.FetcherName{
+		constants.FetcherNameSigningInfo,
+		constants.FetcherNameSlashingParams,
+		constants.FetcherNameMissedBlocksRate,
+	}
+}
+
+func (g *JailRiskGenerator) Generate(state *statePkg.State) []prometheus.Collector {
+	signingInfos, ok := statePkg.Get(state, fetchersPkg.SigningInfoKey)
+	if !ok {
+		return []prometheus.Collector{}
+	}
+
+	slashingParams, ok := statePkg.Get(state, fetchersPkg.SlashingParamsKey)
+	if !ok {
+		return []prometheus.Collector{}
+	}
+
+	// The miss rate might fail to be fetched, the rest is still returned then.
+	missedBlocksRates, _ := statePkg.Get(state, fetchersPkg.MissedBlocksRateKey)
+
+	missedBlocksUntilJailGauge := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: constants.MetricsPrefix + "missed_blocks_until_jail",
+			Help: "How many more blocks the validator can miss within the window without being jailed",
+		},
+		[]string{"chain", "address"},
+	)
+
+	secondsUntilJailGauge := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: constants.MetricsPrefix + "estimated_seconds_until_jail",
+			Help: "Estimated seconds until the validator is jailed, if its missed blocks counter keeps growing at the current rate",
+		},
+		[]string{"chain", "address"},
+	)
+
+	secondsUntilUnjailGauge := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: constants.MetricsPrefix + "seconds_until_unjail",
+			Help: "Seconds remaining until the validator can be unjailed, 0 if it already can or is not jailed",
+		},
+		[]string{"chain", "address"},
+	)
+
+	tombstonedGauge := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: constants.MetricsPrefix + "tombstoned",
+			Help: "Whether the validator is tombstoned (1 if yes, 0 if no)",
+		},
+		[]string{"chain", "address"},
+	)
+
+	now := time.Now()
+
+	for chain, validators := range signingInfos.SigningInfos {
+		params, found := slashingParams.Params[chain]
+
+		for validator, signingInfo := range validators {
+			labels := prometheus.Labels{
+				"chain":   chain,
+				"address": validator,
+			}
+
+			info := signingInfo.ValSigningInfo
+
+			if info.Tombstoned {
+				tombstonedGauge.With(labels).Set(1)
+				// can never be unjailed
+				continue
+			}
+
+			tombstonedGauge.With(labels).Set(0)
+			secondsUntilUnjailGauge.With(labels).Set(max(info.JailedUntil.Sub(now).Seconds(), 0))
+
+			if !found || info.MissedBlocksCounter.IsNil() {
+				continue
+			}
+
+			missed := info.MissedBlocksCounter.Int64()
+			missedUntilJail := max(params.SlashingParams.MaxMissedBlocks()-missed, 0)
+			missedBlocksUntilJailGauge.With(labels).Set(float64(missedUntilJail))
+
+			missRate, rateFound := missedBlocksRates.Rates[chain][validator]
+			if !rateFound || missRate <= 0 {
+				continue
+			}
+
+			// The validator is jailed on the first block missed above the allowed amount.
+			secondsUntilJailGauge.With(labels).Set(float64(missedUntilJail+1) / missRate)
+		}
+	}
+
+	return []prometheus.Collector{
+		missedBlocksUntilJailGauge,
+		secondsUntilJailGauge,
+		secondsUntilUnjailGauge,
+		tombstonedGauge,
+	}
+}
@@ -0,0 +1,168 @@
+package generators
+
+import (
+	"main/pkg/fetchers"
+	statePkg "main/pkg/state"
+	"main/pkg/types"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJailRiskGeneratorNoState(t *testing.T) {
+	t.Parallel()
+
+	state := statePkg.NewState()
+	generator := NewJailRiskGenerator()
+	results := generator.Generate(state)
+	assert.Empty(t, results)
+}
+
+func TestJailRiskGeneratorNoSlashingParams(t *testing.T) {
+	t.Parallel()
+
+	state := statePkg.NewState()
+	statePkg.Set(state, fetchers.SigningInfoKey, fetchers.SigningInfoData{})
+
+	generator := NewJailRiskGenerator()
+	results := generator.Generate(state)
+	assert.Empty(t, results)
+}
+
+func TestJailRiskGeneratorNotEmptyState(t *testing.T) {
+	t.Parallel()
+
+	state := statePkg.NewState()
+	statePkg.Set(state, fetchers.SigningInfoKey, fetchers.SigningInfoData{
+		SigningInfos: map[string]map[string]*types.SigningInfoResponse{
+			"chain": {
+				"validator": {
+					ValSigningInfo: types.SigningInfo{MissedBlocksCounter: math.NewInt(400)},
+				},
+				"jailed": {
+					ValSigningInfo: types.SigningInfo{
+						MissedBlocksCounter: math.NewInt(0),
+						JailedUntil:         time.Now().Add(time.Hour),
+					},
+				},
+				"tombstoned": {
+					ValSigningInfo: types.SigningInfo{
+						MissedBlocksCounter: math.NewInt(0),
+						Tombstoned:          true,
+					},
+				},
+			},
+			"consumer": {
+				// no slashing params for this chain
+				"validator": {
+					ValSigningInfo: types.SigningInfo{MissedBlocksCounter: math.NewInt(10)},
+				},
+			},
+		},
+	})
+	statePkg.Set(state, fetchers.SlashingParamsKey, fetchers.SlashingParamsData{
+		Params: map[string]*types.SlashingParamsResponse{
+			"chain": {
+				SlashingParams: types.SlashingParams{
+					SignedBlocksWindow: math.NewInt(1000),
+					MinSignedPerWindow: math.LegacyMustNewDecFromStr("0.5"),
+				},
+			},
+		},
+	})
+
+	statePkg.Set(state, fetchers.MissedBlocksRateKey, fetchers.MissedBlocksRateData{
+		Rates: map[string]map[string]float64{
+			"chain": {"validator": 1},
+		},
+	})
+
+	generator := NewJailRiskGenerator()
+	results := generator.Generate(state)
+	assert.Len(t, results, 4)
+
+	missedUntilJail, ok := results[0].(*prometheus.GaugeVec)
+	assert.True(t, ok)
+	assert.Equal(t, 2, testutil.CollectAndCount(missedUntilJail))
+	assert.InDelta(t, 100, testutil.ToFloat64(missedUntilJail.With(prometheus.Labels{
+		"chain":   "chain",
+		"address": "validator",
+	})), 0.01)
+	assert.InDelta(t, 500, testutil.ToFloat64(missedUntilJail.With(prometheus.Labels{
+		"chain":   "chain",
+		"address": "jailed",
+	})), 0.01)
+
+	secondsUntilJail, ok := results[1].(*prometheus.GaugeVec)
+	assert.True(t, ok)
+	assert.Equal(t, 1, testutil.CollectAndCount(secondsUntilJail))
+	assert.InDelta(t, 101, testutil.ToFloat64(secondsUntilJail.With(prometheus.Labels{
+		"chain":   "chain",
+		"address": "validator",
+	})), 0.01)
+
+	secondsUntilUnjail, ok := results[2].(*prometheus.GaugeVec)
+	assert.True(t, ok)
+	assert.Equal(t, 3, testutil.CollectAndCount(secondsUntilUnjail))
+	assert.InDelta(t, 3600, testutil.ToFloat64(secondsUntilUnjail.With(prometheus.Labels{
+		"chain":   "chain",
+		"address": "jailed",
+	})), 5)
+	assert.Zero(t, testutil.ToFloat64(secondsUntilUnjail.With(prometheus.Labels{
+		"chain":   "chain",
+		"address": "validator",
+	})))
+
+	tombstoned, ok := results[3].(*prometheus.GaugeVec)
+	assert.True(t, ok)
+	assert.Equal(t, 4, testutil.CollectAndCount(tombstoned))
+	assert.InDelta(t, 1, testutil.ToFloat64(tombstoned.With(prometheus.Labels{
+		"chain":   "chain",
+		"address": "tombstoned",
+	})), 0.01)
+}
+
+func TestJailRiskGeneratorCounterNotGrowing(t *testing.T) {
+	t.Parallel()
+
+	state := statePkg.NewState()
+	statePkg.Set(state, fetchers.SigningInfoKey, fetchers.SigningInfoData{
+		SigningInfos: map[string]map[string]*types.SigningInfoResponse{
+			"chain": {
+				"validator": {
+					ValSigningInfo: types.SigningInfo{MissedBlocksCounter: math.NewInt(100)},
+				},
+			},
+		},
+	})
+	statePkg.Set(state, fetchers.SlashingParamsKey, fetchers.SlashingParamsData{
+		Params: map[string]*types.SlashingParamsResponse{
+			"chain": {
+				SlashingParams: types.SlashingParams{
+					SignedBlocksWindow: math.NewInt(1000),
+					MinSignedPerWindow: math.LegacyMustNewDecFromStr("0.5"),
+				},
+			},
+		},
+	})
+
+	statePkg.Set(state, fetchers.MissedBlocksRateKey, fetchers.MissedBlocksRateData{
+		Rates: map[string]map[string]float64{
+			"chain": {"validator": 0},
+		},
+	})
+
+	generator := NewJailRiskGenerator()
+
+	results := generator.Generate(state)
+	assert.Len(t, results, 4)
+
+	secondsUntilJail, ok := results[1].(*prometheus.GaugeVec)
+	assert.True(t, ok)
+	assert.Equal(t, 0, testutil.CollectAndCount(secondsUntilJail))
+}
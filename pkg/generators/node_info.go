@@ -15,8 +15,16 @@ func NewNodeInfoGenerator() *NodeInfoGenerator {
 	return &NodeInfoGenerator{}
 }
 
+func (g *NodeInfoGenerator) Name() constants.GeneratorName {
+	return constants.GeneratorNameNodeInfo
+}
+
+func (g *NodeInfoGenerator) Fetchers() []constants.FetcherName {
+	return []constants.FetcherName{constants.FetcherNameNodeInfo}
+}
+
 func (g *NodeInfoGenerator) Generate(state *statePkg.State) []prometheus.Collector {
-	nodeInfos, ok := statePkg.StateGet[fetchersPkg.NodeInfoData](state, constants.FetcherNameNodeInfo)
+	nodeInfos, ok := statePkg.Get(state, fetchersPkg.NodeInfoKey)
 	if !ok {
 		return []prometheus.Collector{}
 	}
@@ -1,7 +1,6 @@
 package generators
 
 import (
-	"main/pkg/constants"
 	"main/pkg/fetchers"
 	statePkg "main/pkg/state"
 	"main/pkg/types"
@@ -26,7 +25,7 @@ func TestNodeInfoGeneratorNotEmptyState(t *testing.T) {
 	t.Parallel()
 
 	state := statePkg.NewState()
-	state.Set(constants.FetcherNameNodeInfo, fetchers.NodeInfoData{
+	statePkg.Set(state, fetchers.NodeInfoKey, fetchers.NodeInfoData{
 		NodeInfos: map[string]*types.NodeInfoResponse{
 			"chain": {
 				DefaultNodeInfo: types.DefaultNodeInfo{Network: "network", Version: "version"},
@@ -15,8 +15,16 @@ func NewPriceGenerator() *PriceGenerator {
 	return &PriceGenerator{}
 }
 
+func (g *PriceGenerator) Name() constants.GeneratorName {
+	return constants.GeneratorNamePrice
+}
+
+func (g *PriceGenerator) Fetchers() []constants.FetcherName {
+	return []constants.FetcherName{constants.FetcherNamePrice}
+}
+
 func (g *PriceGenerator) Generate(state *statePkg.State) []prometheus.Collector {
-	data, ok := statePkg.StateGet[fetchersPkg.PriceData](state, constants.FetcherNamePrice)
+	data, ok := statePkg.Get(state, fetchersPkg.PriceKey)
 	if !ok {
 		return []prometheus.Collector{}
 	}
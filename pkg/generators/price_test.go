@@ -25,7 +25,7 @@ func TestPriceGeneratorNotEmptyState(t *testing.T) {
 	t.Parallel()
 
 	state := statePkg.NewState()
-	state.Set(constants.FetcherNamePrice, fetchers.PriceData{
+	statePkg.Set(state, fetchers.PriceKey, fetchers.PriceData{
 		Prices: map[string]map[string]fetchers.PriceInfo{
 			"chain": {
 				"denom": fetchers.PriceInfo{
@@ -0,0 +1,167 @@
+package generators
+
+import (
+	configPkg "main/pkg/config"
+	"main/pkg/constants"
+	fetchersPkg "main/pkg/fetchers"
+	statePkg "main/pkg/state"
+	"main/pkg/types"
+	"main/pkg/utils"
+
+	"github.com/rs/zerolog"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type ProposersGenerator struct {
+	Chains []*configPkg.Chain
+	Logger zerolog.Logger
+}
+
+func NewProposersGenerator(
+	chains []*configPkg.Chain,
+	logger *zerolog.Logger,
+) *ProposersGenerator {
+	return &ProposersGenerator{
+		Chains: chains,
+		Logger: logger.With().Str("component", "proposers_generator").Logger(),
+	}
+}
+
+func (g *ProposersGenerator) Name() constants.GeneratorName {
+	return constants.GeneratorNameProposers
+}
+
+func (g *ProposersGenerator) Fetchers() []constants.FetcherName {
+	return []constants.FetcherName{
+		constants.FetcherNameProposers,
+		constants.FetcherNameValidators,
+	}
+}
+
+func (g *ProposersGenerator) Generate(state *statePkg.State) []prometheus.Collector {
+	data, ok := statePkg.Get(state, fetchersPkg.ProposersKey)
+	if !ok {
+		return []prometheus.Collector{}
+	}
+
+	validatorsData, _ := statePkg.Get(state, fetchersPkg.ValidatorsKey)
+
+	proposerBlocksGauge := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: constants.MetricsPrefix + "proposer_blocks",
+			Help: "Count of the latest blocks the proposed blocks are counted in",
+		},
+		[]string{"chain"},
+	)
+
+	proposedBlocksGauge := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: constants.MetricsPrefix + "proposed_blocks",
+			Help: "Count of the latest blocks proposed by the validator",
+		},
+		[]string{"chain", "address"},
+	)
+
+	proposedEmptyBlocksGauge := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: constants.MetricsPrefix + "proposed_empty_blocks",
+			Help: "Count of the latest blocks proposed by the validator that had no transactions",
+		},
+		[]string{"chain", "address"},
+	)
+
+	expectedProposedBlocksGauge := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: constants.MetricsPrefix + "expected_proposed_blocks",
+			Help: "Count of the latest blocks the validator was expected to propose, based on its voting power share",
+		},
+		[]string{"chain", "address"},
+	)
+
+	for _, chain := range g.Chains {
+		proposers, ok := data.Proposers[chain.Name]
+		if !ok {
+			continue
+		}
+
+		proposerBlocksGauge.With(prometheus.Labels{
+			"chain": chain.Name,
+		}).Set(float64(proposers.Blocks))
+
+		// Validators might fail to be fetched, the proposed blocks are still returned then.
+		chainValidators, hasValidators := validatorsData.Validators[chain.Name]
+
+		for _, validatorAddr := range chain.Validators {
+			if validatorAddr.ConsensusAddress == "" {
+				continue
+			}
+
+			hexAddress, err := utils.Bech32ToHex(validatorAddr.ConsensusAddress)
+			if err != nil {
+				g.Logger.Error().
+					Err(err).
+					Str("chain", chain.Name).
+					Str("validator", validatorAddr.Address).
+					Msg("Error converting valcons to hex address")
+				continue
+			}
+
+			labels := prometheus.Labels{
+				"chain":   chain.Name,
+				"address": validatorAddr.Address,
+			}
+
+			proposedBlocksGauge.With(labels).Set(float64(proposers.Proposed[hexAddress]))
+			proposedEmptyBlocksGauge.With(labels).Set(float64(proposers.ProposedEmpty[hexAddress]))
+
+			if !hasValidators || chainValidators == nil {
+				continue
+			}
+
+			share, found := g.getVotingPowerShare(chainValidators.Validators, validatorAddr.Address)
+			if !found {
+				continue
+			}
+
+			expectedProposedBlocksGauge.With(labels).Set(float64(proposers.Blocks) * share)
+		}
+	}
+
+	return []prometheus.Collector{
+		proposerBlocksGauge,
+		proposedBlocksGauge,
+		proposedEmptyBlocksGauge,
+		expectedProposedBlocksGauge,
+	}
+}
+
+func (g *ProposersGenerator) getVotingPowerShare(
+	validators []types.Validator,
+	address string,
+) (float64, bool) {
+	validator, found := utils.Find(validators, func(v types.Validator) bool {
+		equal, err := utils.CompareTwoBech32(v.OperatorAddress, address)
+		return err == nil && equal
+	})
+	if !found {
+		return 0, false
+	}
+
+	if !validator.Active() {
+		return 0, true
+	}
+
+	totalTokens := 0.0
+	for _, v := range validators {
+		if v.Active() {
+			totalTokens += v.Tokens.MustFloat64()
+		}
+	}
+
+	if totalTokens == 0 {
+		return 0, false
+	}
+
+	return validator.Tokens.MustFloat64() / totalTokens, true
+}
@@ -0,0 +1,155 @@
+package generators
+
+import (
+	"main/pkg/config"
+	"main/pkg/constants"
+	"main/pkg/fetchers"
+	"main/pkg/logger"
+	statePkg "main/pkg/state"
+	"main/pkg/types"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProposersGeneratorNoState(t *testing.T) {
+	t.Parallel()
+
+	state := statePkg.NewState()
+	generator := NewProposersGenerator([]*config.Chain{}, logger.GetNopLogger())
+	results := generator.Generate(state)
+	assert.Empty(t, results)
+}
+
+func TestProposersGeneratorNotEmptyState(t *testing.T) {
+	t.Parallel()
+
+	chains := []*config.Chain{
+		{
+			Name: "chain",
+			Validators: []config.Validator{
+				{
+					Address:          "cosmosvaloper1xqz9pemz5e5zycaa89kys5aw6m8rhgsvw4328e",
+					ConsensusAddress: "cosmosvalcons1rt4g447zhv6jcqwdl447y88guwm0eevnrelgzc",
+				},
+				{
+					// No consensus address, so proposed blocks are unknown.
+					Address: "cosmosvaloper1c4k24jzduc365kywrsvf5ujz4ya6mwympnc4en",
+				},
+				{
+					Address:          "cosmosvaloper1xqz9pemz5e5zycaa89kys5aw6m8rhgsvw4328e",
+					ConsensusAddress: "invalid",
+				},
+			},
+		},
+		{Name: "chain-without-rpc"},
+	}
+
+	state := statePkg.NewState()
+	statePkg.Set(state, fetchers.ProposersKey, fetchers.ProposersData{
+		Proposers: map[string]*types.BlockProposers{
+			"chain": {
+				Blocks:        1000,
+				Proposed:      map[string]int64{"1AEA8AD7C2BB352C01CDFD6BE21CE8E3B6FCE593": 240},
+				ProposedEmpty: map[string]int64{"1AEA8AD7C2BB352C01CDFD6BE21CE8E3B6FCE593": 10},
+			},
+		},
+	})
+	statePkg.Set(state, fetchers.ValidatorsKey, fetchers.ValidatorsData{
+		Validators: map[string]*types.ValidatorsResponse{
+			"chain": {
+				Validators: []types.Validator{
+					{
+						OperatorAddress: "cosmosvaloper1xqz9pemz5e5zycaa89kys5aw6m8rhgsvw4328e",
+						Tokens:          math.LegacyMustNewDecFromStr("25"),
+						Status:          constants.ValidatorStatusBonded,
+					},
+					{
+						OperatorAddress: "cosmosvaloper1c4k24jzduc365kywrsvf5ujz4ya6mwympnc4en",
+						Tokens:          math.LegacyMustNewDecFromStr("75"),
+						Status:          constants.ValidatorStatusBonded,
+					},
+					{
+						OperatorAddress: "cosmosvaloper1unbonded",
+						Tokens:          math.LegacyMustNewDecFromStr("1000"),
+						Status:          "BOND_STATUS_UNBONDED",
+					},
+				},
+			},
+		},
+	})
+
+	generator := NewProposersGenerator(chains, logger.GetNopLogger())
+	results := generator.Generate(state)
+	assert.Len(t, results, 4)
+
+	proposerBlocks, ok := results[0].(*prometheus.GaugeVec)
+	assert.True(t, ok)
+	assert.Equal(t, 1, testutil.CollectAndCount(proposerBlocks))
+	assert.InDelta(t, 1000, testutil.ToFloat64(proposerBlocks.With(prometheus.Labels{
+		"chain": "chain",
+	})), 0.01)
+
+	proposedBlocks, ok := results[1].(*prometheus.GaugeVec)
+	assert.True(t, ok)
+	assert.Equal(t, 1, testutil.CollectAndCount(proposedBlocks))
+	assert.InDelta(t, 240, testutil.ToFloat64(proposedBlocks.With(prometheus.Labels{
+		"chain":   "chain",
+		"address": "cosmosvaloper1xqz9pemz5e5zycaa89kys5aw6m8rhgsvw4328e",
+	})), 0.01)
+
+	proposedEmptyBlocks, ok := results[2].(*prometheus.GaugeVec)
+	assert.True(t, ok)
+	assert.Equal(t, 1, testutil.CollectAndCount(proposedEmptyBlocks))
+	assert.InDelta(t, 10, testutil.ToFloat64(proposedEmptyBlocks.With(prometheus.Labels{
+		"chain":   "chain",
+		"address": "cosmosvaloper1xqz9pemz5e5zycaa89kys5aw6m8rhgsvw4328e",
+	})), 0.01)
+
+	expectedProposedBlocks, ok := results[3].(*prometheus.GaugeVec)
+	assert.True(t, ok)
+	assert.Equal(t, 1, testutil.CollectAndCount(expectedProposedBlocks))
+	assert.InDelta(t, 250, testutil.ToFloat64(expectedProposedBlocks.With(prometheus.Labels{
+		"chain":   "chain",
+		"address": "cosmosvaloper1xqz9pemz5e5zycaa89kys5aw6m8rhgsvw4328e",
+	})), 0.01)
+}
+
+func TestProposersGeneratorNoValidators(t *testing.T) {
+	t.Parallel()
+
+	chains := []*config.Chain{{
+		Name: "chain",
+		Validators: []config.Validator{{
+			Address:          "cosmosvaloper1xqz9pemz5e5zycaa89kys5aw6m8rhgsvw4328e",
+			ConsensusAddress: "cosmosvalcons1rt4g447zhv6jcqwdl447y88guwm0eevnrelgzc",
+		}},
+	}}
+
+	state := statePkg.NewState()
+	statePkg.Set(state, fetchers.ProposersKey, fetchers.ProposersData{
+		Proposers: map[string]*types.BlockProposers{
+			"chain": {
+				Blocks:        1000,
+				Proposed:      map[string]int64{},
+				ProposedEmpty: map[string]int64{},
+			},
+		},
+	})
+
+	generator := NewProposersGenerator(chains, logger.GetNopLogger())
+	results := generator.Generate(state)
+	assert.Len(t, results, 4)
+
+	proposedBlocks, ok := results[1].(*prometheus.GaugeVec)
+	assert.True(t, ok)
+	assert.Equal(t, 1, testutil.CollectAndCount(proposedBlocks))
+
+	expectedProposedBlocks, ok := results[3].(*prometheus.GaugeVec)
+	assert.True(t, ok)
+	assert.Equal(t, 0, testutil.CollectAndCount(expectedProposedBlocks))
+}
@@ -0,0 +1,87 @@
+package generators
+
+import (
+	"main/pkg/constants"
+	fetchersPkg "main/pkg/fetchers"
+	statePkg "main/pkg/state"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type RecentBlocksGenerator struct {
+}
+
+func NewRecentBlocksGenerator() *RecentBlocksGenerator {
+	return &RecentBlocksGenerator{}
+}
+
+func (g *RecentBlocksGenerator) Name() constants.GeneratorName {
+	return constants.GeneratorNameRecentBlocks
+}
+
+func (g *RecentBlocksGenerator) Fetchers() []constants.FetcherName {
+	return []constants.FetcherName{constants.FetcherNameRecentBlocks}
+}
+
+func (g *RecentBlocksGenerator) Generate(state *statePkg.State) []prometheus.Collector {
+	data, ok := statePkg.Get(state, fetchersPkg.RecentBlocksKey)
+	if !ok {
+		return []prometheus.Collector{}
+	}
+
+	blocksGauge := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: constants.MetricsPrefix + "recent_blocks",
+			Help: "Count of the latest blocks validator's signatures were checked in",
+		},
+		[]string{"chain", "address"},
+	)
+
+	missedBlocksGauge := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: constants.MetricsPrefix + "recent_blocks_missed",
+			Help: "Validator's missed blocks among the latest blocks",
+		},
+		[]string{"chain", "address"},
+	)
+
+	consecutiveMissedGauge := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: constants.MetricsPrefix + "recent_blocks_consecutive_missed",
+			Help: "Validator's blocks missed in a row, up to the latest block",
+		},
+		[]string{"chain", "address"},
+	)
+
+	lastSignedHeightGauge := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: constants.MetricsPrefix + "last_signed_height",
+			Help: "Height of the latest block the validator has signed, if it's among the latest blocks",
+		},
+		[]string{"chain", "address"},
+	)
+
+	for chain, validators := range data.Signatures {
+		for validator, signatures := range validators {
+			labels := prometheus.Labels{
+				"chain":   chain,
+				"address": validator,
+			}
+
+			blocksGauge.With(labels).Set(float64(signatures.Blocks))
+			missedBlocksGauge.With(labels).Set(float64(signatures.Missed))
+			consecutiveMissedGauge.With(labels).Set(float64(signatures.ConsecutiveMissed))
+
+			if signatures.LastSignedHeight > 0 {
+				lastSignedHeightGauge.With(labels).Set(float64(signatures.LastSignedHeight))
+			}
+		}
+	}
+
+	return []prometheus.Collector{
+		blocksGauge,
+		missedBlocksGauge,
+		consecutiveMissedGauge,
+		lastSignedHeightGauge,
+	}
+}
@@ -0,0 +1,82 @@
+package generators
+
+import (
+	"main/pkg/fetchers"
+	statePkg "main/pkg/state"
+	"main/pkg/types"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecentBlocksGeneratorNoState(t *testing.T) {
+	t.Parallel()
+
+	state := statePkg.NewState()
+	generator := NewRecentBlocksGenerator()
+	results := generator.Generate(state)
+	assert.Empty(t, results)
+}
+
+func TestRecentBlocksGeneratorNotEmptyState(t *testing.T) {
+	t.Parallel()
+
+	state := statePkg.NewState()
+	statePkg.Set(state, fetchers.RecentBlocksKey, fetchers.RecentBlocksData{
+		Signatures: map[string]map[string]*types.ValidatorSignatures{
+			"chain": {
+				"validator": {
+					Blocks:            100,
+					Missed:            10,
+					ConsecutiveMissed: 3,
+					LastSignedHeight:  1000,
+				},
+				"validator2": {
+					Blocks: 100,
+					Missed: 100,
+					// never signed
+					ConsecutiveMissed: 100,
+				},
+			},
+		},
+	})
+
+	generator := NewRecentBlocksGenerator()
+	results := generator.Generate(state)
+	assert.Len(t, results, 4)
+
+	blocks, ok := results[0].(*prometheus.GaugeVec)
+	assert.True(t, ok)
+	assert.Equal(t, 2, testutil.CollectAndCount(blocks))
+	assert.InDelta(t, 100, testutil.ToFloat64(blocks.With(prometheus.Labels{
+		"chain":   "chain",
+		"address": "validator",
+	})), 0.01)
+
+	missed, ok := results[1].(*prometheus.GaugeVec)
+	assert.True(t, ok)
+	assert.Equal(t, 2, testutil.CollectAndCount(missed))
+	assert.InDelta(t, 10, testutil.ToFloat64(missed.With(prometheus.Labels{
+		"chain":   "chain",
+		"address": "validator",
+	})), 0.01)
+
+	consecutiveMissed, ok := results[2].(*prometheus.GaugeVec)
+	assert.True(t, ok)
+	assert.Equal(t, 2, testutil.CollectAndCount(consecutiveMissed))
+	assert.InDelta(t, 100, testutil.ToFloat64(consecutiveMissed.With(prometheus.Labels{
+		"chain":   "chain",
+		"address": "validator2",
+	})), 0.01)
+
+	lastSignedHeight, ok := results[3].(*prometheus.GaugeVec)
+	assert.True(t, ok)
+	assert.Equal(t, 1, testutil.CollectAndCount(lastSignedHeight))
+	assert.InDelta(t, 1000, testutil.ToFloat64(lastSignedHeight.With(prometheus.Labels{
+		"chain":   "chain",
+		"address": "validator",
+	})), 0.01)
+}
@@ -17,8 +17,16 @@ func NewRewardsGenerator(chains []*config.Chain) *RewardsGenerator {
 	return &RewardsGenerator{Chains: chains}
 }
 
+func (g *RewardsGenerator) Name() constants.GeneratorName {
+	return constants.GeneratorNameRewards
+}
+
+func (g *RewardsGenerator) Fetchers() []constants.FetcherName {
+	return []constants.FetcherName{constants.FetcherNameRewards}
+}
+
 func (g *RewardsGenerator) Generate(state *statePkg.State) []prometheus.Collector {
-	data, ok := statePkg.StateGet[fetchersPkg.RewardsData](state, constants.FetcherNameRewards)
+	data, ok := statePkg.Get(state, fetchersPkg.RewardsKey)
 	if !ok {
 		return []prometheus.Collector{}
 	}
@@ -2,7 +2,6 @@ package generators
 
 import (
 	"main/pkg/config"
-	"main/pkg/constants"
 	"main/pkg/fetchers"
 	statePkg "main/pkg/state"
 	"main/pkg/types"
@@ -29,7 +28,7 @@ func TestRewardsGeneratorNotEmptyState(t *testing.T) {
 	t.Parallel()
 
 	state := statePkg.NewState()
-	state.Set(constants.FetcherNameRewards, fetchers.RewardsData{
+	statePkg.Set(state, fetchers.RewardsKey, fetchers.RewardsData{
 		Rewards: map[string]map[string][]types.Amount{
 			"chain": {
 				"validator": []types.Amount{
@@ -17,8 +17,16 @@ func NewSelfDelegationGenerator(chains []*config.Chain) *SelfDelegationGenerator
 	return &SelfDelegationGenerator{Chains: chains}
 }
 
+func (g *SelfDelegationGenerator) Name() constants.GeneratorName {
+	return constants.GeneratorNameSelfDelegation
+}
+
+func (g *SelfDelegationGenerator) Fetchers() []constants.FetcherName {
+	return []constants.FetcherName{constants.FetcherNameSelfDelegation}
+}
+
 func (g *SelfDelegationGenerator) Generate(state *statePkg.State) []prometheus.Collector {
-	data, ok := statePkg.StateGet[fetchersPkg.SelfDelegationData](state, constants.FetcherNameSelfDelegation)
+	data, ok := statePkg.Get(state, fetchersPkg.SelfDelegationKey)
 	if !ok {
 		return []prometheus.Collector{}
 	}
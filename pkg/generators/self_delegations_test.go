@@ -2,7 +2,6 @@ package generators
 
 import (
 	"main/pkg/config"
-	"main/pkg/constants"
 	"main/pkg/fetchers"
 	statePkg "main/pkg/state"
 	"main/pkg/types"
@@ -29,7 +28,7 @@ func TestSelfDelegationGeneratorNotEmptyState(t *testing.T) {
 	t.Parallel()
 
 	state := statePkg.NewState()
-	state.Set(constants.FetcherNameSelfDelegation, fetchers.SelfDelegationData{
+	statePkg.Set(state, fetchers.SelfDelegationKey, fetchers.SelfDelegationData{
 		Delegations: map[string]map[string]*types.Amount{
 			"chain": {
 				"validator":  {Amount: 100000, Denom: "uatom"},
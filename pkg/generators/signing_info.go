@@ -15,8 +15,16 @@ func NewSigningInfoGenerator() *SigningInfoGenerator {
 	return &SigningInfoGenerator{}
 }
 
+func (g *SigningInfoGenerator) Name() constants.GeneratorName {
+	return constants.GeneratorNameSigningInfo
+}
+
+func (g *SigningInfoGenerator) Fetchers() []constants.FetcherName {
+	return []constants.FetcherName{constants.FetcherNameSigningInfo}
+}
+
 func (g *SigningInfoGenerator) Generate(state *statePkg.State) []prometheus.Collector {
-	data, ok := statePkg.StateGet[fetchersPkg.SigningInfoData](state, constants.FetcherNameSigningInfo)
+	data, ok := statePkg.Get(state, fetchersPkg.SigningInfoKey)
 	if !ok {
 		return []prometheus.Collector{}
 	}
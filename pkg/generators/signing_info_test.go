@@ -1,7 +1,6 @@
 package generators
 
 import (
-	"main/pkg/constants"
 	"main/pkg/fetchers"
 	statePkg "main/pkg/state"
 	"main/pkg/types"
@@ -28,7 +27,7 @@ func TestSigningInfoGeneratorNotEmptyState(t *testing.T) {
 	t.Parallel()
 
 	state := statePkg.NewState()
-	state.Set(constants.FetcherNameSigningInfo, fetchers.SigningInfoData{
+	statePkg.Set(state, fetchers.SigningInfoKey, fetchers.SigningInfoData{
 		SigningInfos: map[string]map[string]*types.SigningInfoResponse{
 			"chain": {
 				"validator": &types.SigningInfoResponse{
@@ -28,8 +28,16 @@ func NewSingleValidatorInfoGenerator(
 	}
 }
 
+func (g *SingleValidatorInfoGenerator) Name() constants.GeneratorName {
+	return constants.GeneratorNameSingleValidatorInfo
+}
+
+func (g *SingleValidatorInfoGenerator) Fetchers() []constants.FetcherName {
+	return []constants.FetcherName{constants.FetcherNameValidators}
+}
+
 func (g *SingleValidatorInfoGenerator) Generate(state *statePkg.State) []prometheus.Collector {
-	data, ok := statePkg.StateGet[fetchersPkg.ValidatorsData](state, constants.FetcherNameValidators)
+	data, ok := statePkg.Get(state, fetchersPkg.ValidatorsKey)
 	if !ok {
 		return []prometheus.Collector{}
 	}
@@ -32,7 +32,7 @@ func TestSingleValidatorInfoGeneratorNoChainValidators(t *testing.T) {
 
 	chains := []*config.Chain{{Name: "chain"}}
 	state := statePkg.NewState()
-	state.Set(constants.FetcherNameValidators, fetchers.ValidatorsData{})
+	statePkg.Set(state, fetchers.ValidatorsKey, fetchers.ValidatorsData{})
 
 	generator := NewSingleValidatorInfoGenerator(chains, loggerPkg.GetNopLogger())
 	results := generator.Generate(state)
@@ -51,7 +51,7 @@ func TestSingleValidatorInfoGeneratorNotFound(t *testing.T) {
 		Validators: []config.Validator{{Address: "validator"}},
 	}}
 	state := statePkg.NewState()
-	state.Set(constants.FetcherNameValidators, fetchers.ValidatorsData{
+	statePkg.Set(state, fetchers.ValidatorsKey, fetchers.ValidatorsData{
 		Validators: map[string]*types.ValidatorsResponse{
 			"chain": {
 				Validators: []types.Validator{
@@ -84,7 +84,7 @@ func TestSingleValidatorInfoGeneratorActive(t *testing.T) {
 		Validators: []config.Validator{{Address: "cosmosvaloper1xqz9pemz5e5zycaa89kys5aw6m8rhgsvw4328e"}},
 	}}
 	state := statePkg.NewState()
-	state.Set(constants.FetcherNameValidators, fetchers.ValidatorsData{
+	statePkg.Set(state, fetchers.ValidatorsKey, fetchers.ValidatorsData{
 		Validators: map[string]*types.ValidatorsResponse{
 			"chain": {
 				Validators: []types.Validator{
@@ -178,7 +178,7 @@ func TestSingleValidatorInfoIgnoredBaseDenom(t *testing.T) {
 		Validators: []config.Validator{{Address: "cosmosvaloper1xqz9pemz5e5zycaa89kys5aw6m8rhgsvw4328e"}},
 	}}
 	state := statePkg.NewState()
-	state.Set(constants.FetcherNameValidators, fetchers.ValidatorsData{
+	statePkg.Set(state, fetchers.ValidatorsKey, fetchers.ValidatorsData{
 		Validators: map[string]*types.ValidatorsResponse{
 			"chain": {
 				Validators: []types.Validator{
@@ -15,8 +15,16 @@ func NewSlashingParamsGenerator() *SlashingParamsGenerator {
 	return &SlashingParamsGenerator{}
 }
 
+func (g *SlashingParamsGenerator) Name() constants.GeneratorName {
+	return constants.GeneratorNameSlashingParams
+}
+
+func (g *SlashingParamsGenerator) Fetchers() []constants.FetcherName {
+	return []constants.FetcherName{constants.FetcherNameSlashingParams}
+}
+
 func (g *SlashingParamsGenerator) Generate(state *statePkg.State) []prometheus.Collector {
-	data, ok := statePkg.StateGet[fetchersPkg.SlashingParamsData](state, constants.FetcherNameSlashingParams)
+	data, ok := statePkg.Get(state, fetchersPkg.SlashingParamsKey)
 	if !ok {
 		return []prometheus.Collector{}
 	}
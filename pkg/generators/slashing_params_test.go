@@ -1,7 +1,6 @@
 package generators
 
 import (
-	"main/pkg/constants"
 	"main/pkg/fetchers"
 	statePkg "main/pkg/state"
 	"main/pkg/types"
@@ -28,7 +27,7 @@ func TestSlashingParamsGeneratorNotEmptyState(t *testing.T) {
 	t.Parallel()
 
 	state := statePkg.NewState()
-	state.Set(constants.FetcherNameSlashingParams, fetchers.SlashingParamsData{
+	statePkg.Set(state, fetchers.SlashingParamsKey, fetchers.SlashingParamsData{
 		Params: map[string]*types.SlashingParamsResponse{
 			"chain": {
 				SlashingParams: types.SlashingParams{
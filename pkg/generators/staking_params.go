@@ -15,8 +15,16 @@ func NewStakingParamsGenerator() *StakingParamsGenerator {
 	return &StakingParamsGenerator{}
 }
 
+func (g *StakingParamsGenerator) Name() constants.GeneratorName {
+	return constants.GeneratorNameStakingParams
+}
+
+func (g *StakingParamsGenerator) Fetchers() []constants.FetcherName {
+	return []constants.FetcherName{constants.FetcherNameStakingParams}
+}
+
 func (g *StakingParamsGenerator) Generate(state *statePkg.State) []prometheus.Collector {
-	data, ok := statePkg.StateGet[fetchersPkg.StakingParamsData](state, constants.FetcherNameStakingParams)
+	data, ok := statePkg.Get(state, fetchersPkg.StakingParamsKey)
 	if !ok {
 		return []prometheus.Collector{}
 	}
@@ -1,7 +1,6 @@
 package generators
 
 import (
-	"main/pkg/constants"
 	"main/pkg/fetchers"
 	statePkg "main/pkg/state"
 	"main/pkg/types"
@@ -26,7 +25,7 @@ func TestStakingParamsGeneratorNotEmptyState(t *testing.T) {
 	t.Parallel()
 
 	state := statePkg.NewState()
-	state.Set(constants.FetcherNameStakingParams, fetchers.StakingParamsData{
+	statePkg.Set(state, fetchers.StakingParamsKey, fetchers.StakingParamsData{
 		Params: map[string]*types.StakingParamsResponse{
 			"chain": {
 				StakingParams: types.StakingParams{
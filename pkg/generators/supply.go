@@ -17,8 +17,16 @@ func NewSupplyGenerator(chains []*config.Chain) *SupplyGenerator {
 	return &SupplyGenerator{Chains: chains}
 }
 
+func (g *SupplyGenerator) Name() constants.GeneratorName {
+	return constants.GeneratorNameSupply
+}
+
+func (g *SupplyGenerator) Fetchers() []constants.FetcherName {
+	return []constants.FetcherName{constants.FetcherNameSupply}
+}
+
 func (g *SupplyGenerator) Generate(state *statePkg.State) []prometheus.Collector {
-	data, ok := statePkg.StateGet[fetchersPkg.SupplyData](state, constants.FetcherNameSupply)
+	data, ok := statePkg.Get(state, fetchersPkg.SupplyKey)
 	if !ok {
 		return []prometheus.Collector{}
 	}
@@ -2,7 +2,6 @@ package generators
 
 import (
 	"main/pkg/config"
-	"main/pkg/constants"
 	"main/pkg/fetchers"
 	statePkg "main/pkg/state"
 	"main/pkg/types"
@@ -29,7 +28,7 @@ func TestSupplyGeneratorNotEmptyState(t *testing.T) {
 	t.Parallel()
 
 	state := statePkg.NewState()
-	state.Set(constants.FetcherNameSupply, fetchers.SupplyData{
+	statePkg.Set(state, fetchers.SupplyKey, fetchers.SupplyData{
 		Supplies: map[string][]types.Amount{
 			"chain": {
 				{Amount: 100000, Denom: "uatom"},
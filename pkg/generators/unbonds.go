@@ -15,8 +15,16 @@ func NewUnbondsGenerator() *UnbondsGenerator {
 	return &UnbondsGenerator{}
 }
 
+func (g *UnbondsGenerator) Name() constants.GeneratorName {
+	return constants.GeneratorNameUnbonds
+}
+
+func (g *UnbondsGenerator) Fetchers() []constants.FetcherName {
+	return []constants.FetcherName{constants.FetcherNameUnbonds}
+}
+
 func (g *UnbondsGenerator) Generate(state *statePkg.State) []prometheus.Collector {
-	data, ok := statePkg.StateGet[fetchersPkg.UnbondsData](state, constants.FetcherNameUnbonds)
+	data, ok := statePkg.Get(state, fetchersPkg.UnbondsKey)
 	if !ok {
 		return []prometheus.Collector{}
 	}
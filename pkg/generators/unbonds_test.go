@@ -1,7 +1,6 @@
 package generators
 
 import (
-	"main/pkg/constants"
 	"main/pkg/fetchers"
 	statePkg "main/pkg/state"
 	"testing"
@@ -25,7 +24,7 @@ func TestUnbondsGeneratorNotEmptyState(t *testing.T) {
 	t.Parallel()
 
 	state := statePkg.NewState()
-	state.Set(constants.FetcherNameUnbonds, fetchers.UnbondsData{
+	statePkg.Set(state, fetchers.UnbondsKey, fetchers.UnbondsData{
 		Unbonds: map[string]map[string]uint64{
 			"chain": {
 				"validator": 100,
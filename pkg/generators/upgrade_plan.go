@@ -0,0 +1,114 @@
+package generators
+
+import (
+	"main/pkg/constants"
+	fetchersPkg "main/pkg/fetchers"
+	statePkg "main/pkg/state"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type UpgradePlanGenerator struct {
+}
+
+func NewUpgradePlanGenerator() *UpgradePlanGenerator {
+	return &UpgradePlanGenerator{}
+}
+
+func (g *UpgradePlanGenerator) Name() constants.GeneratorName {
+	return constants.GeneratorNameUpgradePlan
+}
+
+func (g *UpgradePlanGenerator) Fetchers() []constants.FetcherName {
+	return []constants.FetcherName{
+		constants.FetcherNameUpgradePlan,
+		constants.FetcherNameNodeInfo,
+	}
+}
+
+func (g *UpgradePlanGenerator) Generate(state *statePkg.State) []prometheus.Collector {
+	data, ok := statePkg.Get(state, fetchersPkg.UpgradePlanKey)
+	if !ok {
+		return []prometheus.Collector{}
+	}
+
+	// Node info might fail to be fetched, the upgrade plan is still returned then.
+	nodeInfos, _ := statePkg.Get(state, fetchersPkg.NodeInfoKey)
+
+	upgradeHeightGauge := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: constants.MetricsPrefix + "upgrade_height",
+			Help: "Height of the scheduled software upgrade, with the app version the node currently runs",
+		},
+		[]string{"chain", "name", "app_version"},
+	)
+
+	blocksRemainingGauge := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: constants.MetricsPrefix + "upgrade_blocks_remaining",
+			Help: "Blocks remaining until the scheduled software upgrade",
+		},
+		[]string{"chain", "name"},
+	)
+
+	secondsRemainingGauge := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: constants.MetricsPrefix + "upgrade_estimated_seconds_remaining",
+			Help: "Estimated seconds until the scheduled software upgrade, based on the average block time",
+		},
+		[]string{"chain", "name"},
+	)
+
+	appVersionGauge := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: constants.MetricsPrefix + "app_version_info",
+			Help: "App version the node currently runs, always 1",
+		},
+		[]string{"chain", "app_version"},
+	)
+
+	for chain, plan := range data.Plans {
+		appVersion := ""
+		if nodeInfo, found := nodeInfos.NodeInfos[chain]; found && nodeInfo != nil {
+			appVersion = nodeInfo.ApplicationVersion.Version
+
+			appVersionGauge.With(prometheus.Labels{
+				"chain":       chain,
+				"app_version": appVersion,
+			}).Set(1)
+		}
+
+		if plan == nil {
+			continue
+		}
+
+		upgradeHeightGauge.With(prometheus.Labels{
+			"chain":       chain,
+			"name":        plan.Name,
+			"app_version": appVersion,
+		}).Set(float64(plan.Height))
+
+		blockTime, found := data.BlockTimes[chain]
+		if !found {
+			continue
+		}
+
+		labels := prometheus.Labels{
+			"chain": chain,
+			"name":  plan.Name,
+		}
+
+		blocksRemainingGauge.With(labels).Set(float64(blockTime.BlocksUntil(plan.Height)))
+
+		if blockTime.AverageBlockTime > 0 {
+			secondsRemainingGauge.With(labels).Set(blockTime.DurationUntil(plan.Height).Seconds())
+		}
+	}
+
+	return []prometheus.Collector{
+		upgradeHeightGauge,
+		blocksRemainingGauge,
+		secondsRemainingGauge,
+		appVersionGauge,
+	}
+}
@@ -0,0 +1,94 @@
+package generators
+
+import (
+	"main/pkg/fetchers"
+	statePkg "main/pkg/state"
+	"main/pkg/types"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpgradePlanGeneratorNoState(t *testing.T) {
+	t.Parallel()
+
+	state := statePkg.NewState()
+	generator := NewUpgradePlanGenerator()
+	results := generator.Generate(state)
+	assert.Empty(t, results)
+}
+
+func TestUpgradePlanGeneratorNotEmptyState(t *testing.T) {
+	t.Parallel()
+
+	state := statePkg.NewState()
+	statePkg.Set(state, fetchers.UpgradePlanKey, fetchers.UpgradePlanData{
+		Plans: map[string]*types.UpgradePlan{
+			"chain":    {Name: "v22", Height: 22001000},
+			"consumer": {Name: "v5", Height: 1000},
+			"other":    nil,
+		},
+		BlockTimes: map[string]*types.BlockTime{
+			"chain": {Height: 22000000, AverageBlockTime: 6200 * time.Millisecond},
+		},
+	})
+
+	nodeInfo := &types.NodeInfoResponse{}
+	nodeInfo.ApplicationVersion.Version = "v21.0.1"
+	otherNodeInfo := &types.NodeInfoResponse{}
+	otherNodeInfo.ApplicationVersion.Version = "v3.0.0"
+	statePkg.Set(state, fetchers.NodeInfoKey, fetchers.NodeInfoData{
+		NodeInfos: map[string]*types.NodeInfoResponse{"chain": nodeInfo, "other": otherNodeInfo},
+	})
+
+	generator := NewUpgradePlanGenerator()
+	results := generator.Generate(state)
+	assert.Len(t, results, 4)
+
+	height, ok := results[0].(*prometheus.GaugeVec)
+	assert.True(t, ok)
+	assert.Equal(t, 2, testutil.CollectAndCount(height))
+	assert.InDelta(t, 22001000, testutil.ToFloat64(height.With(prometheus.Labels{
+		"chain":       "chain",
+		"name":        "v22",
+		"app_version": "v21.0.1",
+	})), 0.01)
+	assert.InDelta(t, 1000, testutil.ToFloat64(height.With(prometheus.Labels{
+		"chain":       "consumer",
+		"name":        "v5",
+		"app_version": "",
+	})), 0.01)
+
+	blocksRemaining, ok := results[1].(*prometheus.GaugeVec)
+	assert.True(t, ok)
+	assert.Equal(t, 1, testutil.CollectAndCount(blocksRemaining))
+	assert.InDelta(t, 1000, testutil.ToFloat64(blocksRemaining.With(prometheus.Labels{
+		"chain": "chain",
+		"name":  "v22",
+	})), 0.01)
+
+	secondsRemaining, ok := results[2].(*prometheus.GaugeVec)
+	assert.True(t, ok)
+	assert.Equal(t, 1, testutil.CollectAndCount(secondsRemaining))
+	assert.InDelta(t, 6200, testutil.ToFloat64(secondsRemaining.With(prometheus.Labels{
+		"chain": "chain",
+		"name":  "v22",
+	})), 0.01)
+
+	// exported for the chains with no upgrade scheduled as well
+	appVersion, ok := results[3].(*prometheus.GaugeVec)
+	assert.True(t, ok)
+	assert.Equal(t, 2, testutil.CollectAndCount(appVersion))
+	assert.InDelta(t, 1, testutil.ToFloat64(appVersion.With(prometheus.Labels{
+		"chain":       "chain",
+		"app_version": "v21.0.1",
+	})), 0.01)
+	assert.InDelta(t, 1, testutil.ToFloat64(appVersion.With(prometheus.Labels{
+		"chain":       "other",
+		"app_version": "v3.0.0",
+	})), 0.01)
+}
@@ -16,6 +16,14 @@ func NewUptimeGenerator() *UptimeGenerator {
 	return &UptimeGenerator{StartTime: time.Now()}
 }
 
+func (g *UptimeGenerator) Name() constants.GeneratorName {
+	return constants.GeneratorNameUptime
+}
+
+func (g *UptimeGenerator) Fetchers() []constants.FetcherName {
+	return []constants.FetcherName{}
+}
+
 func (g *UptimeGenerator) Generate(state *statePkg.State) []prometheus.Collector {
 	uptimeMetricsGauge := prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
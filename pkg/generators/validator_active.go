@@ -28,13 +28,24 @@ func NewValidatorActiveGenerator(
 	}
 }
 
+func (g *ValidatorActiveGenerator) Name() constants.GeneratorName {
+	return constants.GeneratorNameValidatorActive
+}
+
+func (g *ValidatorActiveGenerator) Fetchers() []constants.FetcherName {
+	return []constants.FetcherName{
+		constants.FetcherNameValidators,
+		constants.FetcherNameConsumerValidators,
+	}
+}
+
 func (g *ValidatorActiveGenerator) Generate(state *statePkg.State) []prometheus.Collector {
-	validators, ok := statePkg.StateGet[fetchersPkg.ValidatorsData](state, constants.FetcherNameValidators)
+	validators, ok := statePkg.Get(state, fetchersPkg.ValidatorsKey)
 	if !ok {
 		return []prometheus.Collector{}
 	}
 
-	allConsumerValidators, ok := statePkg.StateGet[fetchersPkg.ConsumerValidatorsData](state, constants.FetcherNameConsumerValidators)
+	allConsumerValidators, ok := statePkg.Get(state, fetchersPkg.ConsumerValidatorsKey)
 	if !ok {
 		return []prometheus.Collector{}
 	}
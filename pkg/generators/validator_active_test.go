@@ -30,7 +30,7 @@ func TestValidatorActiveGeneratorNoConsumerValidators(t *testing.T) {
 
 	chains := []*config.Chain{{Name: "chain"}}
 	state := statePkg.NewState()
-	state.Set(constants.FetcherNameValidators, fetchers.ValidatorsData{})
+	statePkg.Set(state, fetchers.ValidatorsKey, fetchers.ValidatorsData{})
 
 	generator := NewValidatorActiveGenerator(chains, loggerPkg.GetNopLogger())
 	results := generator.Generate(state)
@@ -42,8 +42,8 @@ func TestValidatorActiveGeneratorNoChainValidators(t *testing.T) {
 
 	chains := []*config.Chain{{Name: "chain"}}
 	state := statePkg.NewState()
-	state.Set(constants.FetcherNameValidators, fetchers.ValidatorsData{})
-	state.Set(constants.FetcherNameConsumerValidators, fetchers.ConsumerValidatorsData{})
+	statePkg.Set(state, fetchers.ValidatorsKey, fetchers.ValidatorsData{})
+	statePkg.Set(state, fetchers.ConsumerValidatorsKey, fetchers.ConsumerValidatorsData{})
 
 	generator := NewValidatorActiveGenerator(chains, loggerPkg.GetNopLogger())
 	results := generator.Generate(state)
@@ -62,7 +62,7 @@ func TestValidatorActiveGeneratorNotFound(t *testing.T) {
 		Validators: []config.Validator{{Address: "validator"}},
 	}}
 	state := statePkg.NewState()
-	state.Set(constants.FetcherNameValidators, fetchers.ValidatorsData{
+	statePkg.Set(state, fetchers.ValidatorsKey, fetchers.ValidatorsData{
 		Validators: map[string]*types.ValidatorsResponse{
 			"chain": {
 				Validators: []types.Validator{
@@ -73,7 +73,7 @@ func TestValidatorActiveGeneratorNotFound(t *testing.T) {
 			},
 		},
 	})
-	state.Set(constants.FetcherNameConsumerValidators, fetchers.ConsumerValidatorsData{})
+	statePkg.Set(state, fetchers.ConsumerValidatorsKey, fetchers.ConsumerValidatorsData{})
 
 	generator := NewValidatorActiveGenerator(chains, loggerPkg.GetNopLogger())
 	results := generator.Generate(state)
@@ -94,7 +94,7 @@ func TestValidatorActiveGeneratorProvider(t *testing.T) {
 		Validators: []config.Validator{{Address: "cosmosvaloper1xqz9pemz5e5zycaa89kys5aw6m8rhgsvw4328e"}},
 	}}
 	state := statePkg.NewState()
-	state.Set(constants.FetcherNameValidators, fetchers.ValidatorsData{
+	statePkg.Set(state, fetchers.ValidatorsKey, fetchers.ValidatorsData{
 		Validators: map[string]*types.ValidatorsResponse{
 			"chain": {
 				Validators: []types.Validator{
@@ -112,7 +112,7 @@ func TestValidatorActiveGeneratorProvider(t *testing.T) {
 			},
 		},
 	})
-	state.Set(constants.FetcherNameConsumerValidators, fetchers.ConsumerValidatorsData{})
+	statePkg.Set(state, fetchers.ConsumerValidatorsKey, fetchers.ConsumerValidatorsData{})
 
 	generator := NewValidatorActiveGenerator(chains, loggerPkg.GetNopLogger())
 	results := generator.Generate(state)
@@ -143,7 +143,7 @@ func TestValidatorActiveGeneratorConsumer(t *testing.T) {
 		},
 	}}
 	state := statePkg.NewState()
-	state.Set(constants.FetcherNameValidators, fetchers.ValidatorsData{
+	statePkg.Set(state, fetchers.ValidatorsKey, fetchers.ValidatorsData{
 		Validators: map[string]*types.ValidatorsResponse{
 			"chain": {
 				Validators: []types.Validator{
@@ -161,7 +161,7 @@ func TestValidatorActiveGeneratorConsumer(t *testing.T) {
 			},
 		},
 	})
-	state.Set(constants.FetcherNameConsumerValidators, fetchers.ConsumerValidatorsData{
+	statePkg.Set(state, fetchers.ConsumerValidatorsKey, fetchers.ConsumerValidatorsData{
 		Validators: map[string]*types.ConsumerValidatorsResponse{
 			"consumer": {
 				Validators: []types.ConsumerValidator{
@@ -28,13 +28,24 @@ func NewValidatorCommissionRateGenerator(
 	}
 }
 
+func (g *ValidatorCommissionRateGenerator) Name() constants.GeneratorName {
+	return constants.GeneratorNameValidatorCommissionRate
+}
+
+func (g *ValidatorCommissionRateGenerator) Fetchers() []constants.FetcherName {
+	return []constants.FetcherName{
+		constants.FetcherNameConsumerCommission,
+		constants.FetcherNameValidators,
+	}
+}
+
 func (g *ValidatorCommissionRateGenerator) Generate(state *statePkg.State) []prometheus.Collector {
-	consumerCommissions, ok := statePkg.StateGet[fetchersPkg.ConsumerCommissionData](state, constants.FetcherNameConsumerCommission)
+	consumerCommissions, ok := statePkg.Get(state, fetchersPkg.ConsumerCommissionKey)
 	if !ok {
 		return []prometheus.Collector{}
 	}
 
-	validators, ok := statePkg.StateGet[fetchersPkg.ValidatorsData](state, constants.FetcherNameValidators)
+	validators, ok := statePkg.Get(state, fetchersPkg.ValidatorsKey)
 	if !ok {
 		return []prometheus.Collector{}
 	}
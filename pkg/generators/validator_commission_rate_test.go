@@ -30,7 +30,7 @@ func TestValidatorCommissionRateGeneratorNoConsumerCommissions(t *testing.T) {
 
 	chains := []*config.Chain{{Name: "chain"}}
 	state := statePkg.NewState()
-	state.Set(constants.FetcherNameConsumerCommission, fetchers.ConsumerCommissionData{})
+	statePkg.Set(state, fetchers.ConsumerCommissionKey, fetchers.ConsumerCommissionData{})
 
 	generator := NewValidatorCommissionRateGenerator(chains, loggerPkg.GetNopLogger())
 	results := generator.Generate(state)
@@ -48,7 +48,7 @@ func TestValidatorCommissionRateInvalidConsumer(t *testing.T) {
 		},
 	}}
 	state := statePkg.NewState()
-	state.Set(constants.FetcherNameValidators, fetchers.ValidatorsData{
+	statePkg.Set(state, fetchers.ValidatorsKey, fetchers.ValidatorsData{
 		Validators: map[string]*types.ValidatorsResponse{
 			"chain": {
 				Validators: []types.Validator{
@@ -57,7 +57,7 @@ func TestValidatorCommissionRateInvalidConsumer(t *testing.T) {
 			},
 		},
 	})
-	state.Set(constants.FetcherNameConsumerCommission, fetchers.ConsumerCommissionData{})
+	statePkg.Set(state, fetchers.ConsumerCommissionKey, fetchers.ConsumerCommissionData{})
 
 	generator := NewValidatorCommissionRateGenerator(chains, loggerPkg.GetNopLogger())
 	results := generator.Generate(state)
@@ -85,7 +85,7 @@ func TestValidatorCommissionRateGeneratorSuccess(t *testing.T) {
 		},
 	}, {Name: "otherchain"}}
 	state := statePkg.NewState()
-	state.Set(constants.FetcherNameValidators, fetchers.ValidatorsData{
+	statePkg.Set(state, fetchers.ValidatorsKey, fetchers.ValidatorsData{
 		Validators: map[string]*types.ValidatorsResponse{
 			"chain": {
 				Validators: []types.Validator{
@@ -108,7 +108,7 @@ func TestValidatorCommissionRateGeneratorSuccess(t *testing.T) {
 			},
 		},
 	})
-	state.Set(constants.FetcherNameConsumerCommission, fetchers.ConsumerCommissionData{
+	statePkg.Set(state, fetchers.ConsumerCommissionKey, fetchers.ConsumerCommissionData{
 		Commissions: map[string]map[string]*types.ConsumerCommissionResponse{
 			"consumer": {
 				"cosmosvaloper1xqz9pemz5e5zycaa89kys5aw6m8rhgsvw4328e": {Rate: math.LegacyMustNewDecFromStr("0.1")},
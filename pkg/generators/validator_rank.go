@@ -29,8 +29,16 @@ func NewValidatorRankGenerator(
 	}
 }
 
+func (g *ValidatorRankGenerator) Name() constants.GeneratorName {
+	return constants.GeneratorNameValidatorRank
+}
+
+func (g *ValidatorRankGenerator) Fetchers() []constants.FetcherName {
+	return []constants.FetcherName{constants.FetcherNameValidators}
+}
+
 func (g *ValidatorRankGenerator) Generate(state *statePkg.State) []prometheus.Collector {
-	data, ok := statePkg.StateGet[fetchersPkg.ValidatorsData](state, constants.FetcherNameValidators)
+	data, ok := statePkg.Get(state, fetchersPkg.ValidatorsKey)
 	if !ok {
 		return []prometheus.Collector{}
 	}
@@ -30,7 +30,7 @@ func TestValidatorRankGeneratorNoChainValidators(t *testing.T) {
 
 	chains := []*config.Chain{{Name: "chain"}}
 	state := statePkg.NewState()
-	state.Set(constants.FetcherNameValidators, fetchers.ValidatorsData{})
+	statePkg.Set(state, fetchers.ValidatorsKey, fetchers.ValidatorsData{})
 
 	generator := NewValidatorRankGenerator(chains, loggerPkg.GetNopLogger())
 	results := generator.Generate(state)
@@ -49,7 +49,7 @@ func TestValidatorRankGeneratorNotFound(t *testing.T) {
 		Validators: []config.Validator{{Address: "validator"}},
 	}}
 	state := statePkg.NewState()
-	state.Set(constants.FetcherNameValidators, fetchers.ValidatorsData{
+	statePkg.Set(state, fetchers.ValidatorsKey, fetchers.ValidatorsData{
 		Validators: map[string]*types.ValidatorsResponse{
 			"chain": {
 				Validators: []types.Validator{
@@ -78,7 +78,7 @@ func TestValidatorRankGeneratorNotActive(t *testing.T) {
 		Validators: []config.Validator{{Address: "cosmosvaloper1xqz9pemz5e5zycaa89kys5aw6m8rhgsvw4328e"}},
 	}}
 	state := statePkg.NewState()
-	state.Set(constants.FetcherNameValidators, fetchers.ValidatorsData{
+	statePkg.Set(state, fetchers.ValidatorsKey, fetchers.ValidatorsData{
 		Validators: map[string]*types.ValidatorsResponse{
 			"chain": {
 				Validators: []types.Validator{
@@ -116,7 +116,7 @@ func TestValidatorRankGeneratorActive(t *testing.T) {
 		Validators: []config.Validator{{Address: "cosmosvaloper1xqz9pemz5e5zycaa89kys5aw6m8rhgsvw4328e"}},
 	}}
 	state := statePkg.NewState()
-	state.Set(constants.FetcherNameValidators, fetchers.ValidatorsData{
+	statePkg.Set(state, fetchers.ValidatorsKey, fetchers.ValidatorsData{
 		Validators: map[string]*types.ValidatorsResponse{
 			"chain": {
 				Validators: []types.Validator{
@@ -21,13 +21,24 @@ func NewValidatorsInfoGenerator(chains []*config.Chain) *ValidatorsInfoGenerator
 	return &ValidatorsInfoGenerator{Chains: chains}
 }
 
+func (g *ValidatorsInfoGenerator) Name() constants.GeneratorName {
+	return constants.GeneratorNameValidatorsInfo
+}
+
+func (g *ValidatorsInfoGenerator) Fetchers() []constants.FetcherName {
+	return []constants.FetcherName{
+		constants.FetcherNameValidators,
+		constants.FetcherNameConsumerValidators,
+	}
+}
+
 func (g *ValidatorsInfoGenerator) Generate(state *statePkg.State) []prometheus.Collector {
-	data, ok := statePkg.StateGet[fetchersPkg.ValidatorsData](state, constants.FetcherNameValidators)
+	data, ok := statePkg.Get(state, fetchersPkg.ValidatorsKey)
 	if !ok {
 		return []prometheus.Collector{}
 	}
 
-	consumersData, ok := statePkg.StateGet[fetchersPkg.ConsumerValidatorsData](state, constants.FetcherNameConsumerValidators)
+	consumersData, ok := statePkg.Get(state, fetchersPkg.ConsumerValidatorsKey)
 	if !ok {
 		return []prometheus.Collector{}
 	}
@@ -30,7 +30,7 @@ func TestValidatorsInfoGeneratorNoConsumerValidators(t *testing.T) {
 	t.Parallel()
 
 	state := statePkg.NewState()
-	state.Set(constants.FetcherNameValidators, fetchers.ValidatorsData{})
+	statePkg.Set(state, fetchers.ValidatorsKey, fetchers.ValidatorsData{})
 
 	generator := NewValidatorsInfoGenerator([]*config.Chain{})
 	results := generator.Generate(state)
@@ -41,7 +41,7 @@ func TestValidatorsInfoGeneratorNotConsumer(t *testing.T) {
 	t.Parallel()
 
 	state := statePkg.NewState()
-	state.Set(constants.FetcherNameValidators, fetchers.ValidatorsData{
+	statePkg.Set(state, fetchers.ValidatorsKey, fetchers.ValidatorsData{
 		Validators: map[string]*types.ValidatorsResponse{
 			"chain": {
 				Validators: []types.Validator{
@@ -63,7 +63,7 @@ func TestValidatorsInfoGeneratorNotConsumer(t *testing.T) {
 			},
 		},
 	})
-	state.Set(constants.FetcherNameConsumerValidators, fetchers.ConsumerValidatorsData{})
+	statePkg.Set(state, fetchers.ConsumerValidatorsKey, fetchers.ConsumerValidatorsData{})
 
 	chains := []*config.Chain{{
 		Name:      "chain",
@@ -92,7 +92,7 @@ func TestValidatorsInfoGeneratorNotConsumerIgnoredBaseDenom(t *testing.T) {
 	t.Parallel()
 
 	state := statePkg.NewState()
-	state.Set(constants.FetcherNameValidators, fetchers.ValidatorsData{
+	statePkg.Set(state, fetchers.ValidatorsKey, fetchers.ValidatorsData{
 		Validators: map[string]*types.ValidatorsResponse{
 			"chain": {
 				Validators: []types.Validator{
@@ -114,7 +114,7 @@ func TestValidatorsInfoGeneratorNotConsumerIgnoredBaseDenom(t *testing.T) {
 			},
 		},
 	})
-	state.Set(constants.FetcherNameConsumerValidators, fetchers.ConsumerValidatorsData{})
+	statePkg.Set(state, fetchers.ConsumerValidatorsKey, fetchers.ConsumerValidatorsData{})
 
 	chains := []*config.Chain{{
 		Name:      "chain",
@@ -140,8 +140,8 @@ func TestValidatorsInfoGeneratorConsumer(t *testing.T) {
 	t.Parallel()
 
 	state := statePkg.NewState()
-	state.Set(constants.FetcherNameValidators, fetchers.ValidatorsData{})
-	state.Set(constants.FetcherNameConsumerValidators, fetchers.ConsumerValidatorsData{
+	statePkg.Set(state, fetchers.ValidatorsKey, fetchers.ValidatorsData{})
+	statePkg.Set(state, fetchers.ConsumerValidatorsKey, fetchers.ConsumerValidatorsData{
 		Validators: map[string]*types.ConsumerValidatorsResponse{
 			"chain": {
 				Validators: []types.ConsumerValidator{
@@ -0,0 +1,39 @@
+package grpc
+
+import (
+	"context"
+	"encoding/base64"
+	"main/pkg/config"
+)
+
+type metadataCredentials struct {
+	metadata map[string]string
+}
+
+func newMetadataCredentials(transportConfig config.TransportConfig) *metadataCredentials {
+	metadata := make(map[string]string, len(transportConfig.Headers)+1)
+
+	for name, value := range transportConfig.Headers {
+		metadata[name] = value
+	}
+
+	if transportConfig.BasicAuthUser != "" {
+		userPassword := transportConfig.BasicAuthUser + ":" + transportConfig.BasicAuthPassword
+		metadata["authorization"] = "Basic " + base64.StdEncoding.EncodeToString([]byte(userPassword))
+	}
+
+	if transportConfig.BearerToken != "" {
+		metadata["authorization"] = "Bearer " + transportConfig.BearerToken
+	}
+
+	return &metadataCredentials{metadata: metadata}
+}
+
+func (c *metadataCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return c.metadata, nil
+}
+
+// RequireTransportSecurity allows the headers on plaintext connections to local nodes.
+func (c *metadataCredentials) RequireTransportSecurity() bool {
+	return false
+}
@@ -0,0 +1,161 @@
+package grpc
+
+import (
+	"encoding/base64"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+const (
+	// gogoproto.customtype
+	gogoprotoCustomTypeOption protowire.Number = 65003
+	// Cosmos SDK decimals are integers multiplied by 10^18.
+	decimalPrecision = 18
+)
+
+// isDecimalField reads gogoproto options from the unknown fields, as the library doesn't know them.
+func isDecimalField(field protoreflect.FieldDescriptor) bool {
+	if field.Kind() != protoreflect.StringKind && field.Kind() != protoreflect.BytesKind {
+		return false
+	}
+
+	options, ok := field.Options().(*descriptorpb.FieldOptions)
+	if !ok || options == nil {
+		return false
+	}
+
+	raw := options.ProtoReflect().GetUnknown()
+
+	for len(raw) > 0 {
+		number, wireType, length := protowire.ConsumeTag(raw)
+		if length < 0 {
+			return false
+		}
+
+		raw = raw[length:]
+
+		if number == gogoprotoCustomTypeOption && wireType == protowire.BytesType {
+			value, valueLength := protowire.ConsumeBytes(raw)
+			if valueLength < 0 {
+				return false
+			}
+
+			return strings.HasSuffix(string(value), "Dec")
+		}
+
+		valueLength := protowire.ConsumeFieldValue(number, wireType, raw)
+		if valueLength < 0 {
+			return false
+		}
+
+		raw = raw[valueLength:]
+	}
+
+	return false
+}
+
+func toDecimal(value string) string {
+	if strings.Contains(value, ".") {
+		return value
+	}
+
+	negative := strings.HasPrefix(value, "-")
+	value = strings.TrimPrefix(value, "-")
+
+	if len(value) <= decimalPrecision {
+		value = strings.Repeat("0", decimalPrecision-len(value)+1) + value
+	}
+
+	decimal := value[:len(value)-decimalPrecision] + "." + value[len(value)-decimalPrecision:]
+	if negative {
+		return "-" + decimal
+	}
+
+	return decimal
+}
+
+func fixDecimals(
+	descriptor protoreflect.MessageDescriptor,
+	value map[string]any,
+	findMessage func(url string) protoreflect.MessageDescriptor,
+) {
+	fields := descriptor.Fields()
+
+	for index := range fields.Len() {
+		field := fields.Get(index)
+		name := string(field.Name())
+
+		fieldValue, ok := value[name]
+		if !ok || field.IsMap() {
+			continue
+		}
+
+		if field.IsList() {
+			items, ok := fieldValue.([]any)
+			if !ok {
+				continue
+			}
+
+			for itemIndex, item := range items {
+				items[itemIndex] = fixDecimalsInValue(field, item, findMessage)
+			}
+
+			continue
+		}
+
+		value[name] = fixDecimalsInValue(field, fieldValue, findMessage)
+	}
+}
+
+func fixDecimalsInValue(
+	field protoreflect.FieldDescriptor,
+	value any,
+	findMessage func(url string) protoreflect.MessageDescriptor,
+) any {
+	if field.Kind() == protoreflect.MessageKind {
+		message, ok := value.(map[string]any)
+		if !ok {
+			return value
+		}
+
+		descriptor := field.Message()
+
+		if descriptor.FullName() == "google.protobuf.Any" {
+			url, _ := message["@type"].(string)
+			if descriptor = findMessage(url); descriptor == nil {
+				return value
+			}
+		}
+
+		fixDecimals(descriptor, message, findMessage)
+
+		return message
+	}
+
+	if !isDecimalField(field) {
+		return value
+	}
+
+	stringValue, ok := value.(string)
+	if !ok {
+		return value
+	}
+
+	if field.Kind() == protoreflect.BytesKind {
+		decoded, err := base64.StdEncoding.DecodeString(stringValue)
+		if err != nil {
+			return value
+		}
+
+		stringValue = string(decoded)
+	}
+
+	if stringValue == "" {
+		stringValue = "0"
+	}
+
+	return toDecimal(stringValue)
+}
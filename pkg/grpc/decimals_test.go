@@ -0,0 +1,18 @@
+package grpc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToDecimal(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "0.050000000000000000", toDecimal("50000000000000000"))
+	assert.Equal(t, "1234.500000000000000000", toDecimal("1234500000000000000000"))
+	assert.Equal(t, "1.000000000000000000", toDecimal("1000000000000000000"))
+	assert.Equal(t, "0.000000000000000000", toDecimal("0"))
+	assert.Equal(t, "-0.000000000000000001", toDecimal("-1"))
+	assert.Equal(t, "0.5", toDecimal("0.5"))
+}
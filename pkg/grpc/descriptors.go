@@ -0,0 +1,217 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc"
+	reflectionPb "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+type Descriptors struct {
+	conn   grpc.ClientConnInterface
+	files  *protoregistry.Files
+	protos map[string]*descriptorpb.FileDescriptorProto
+	mutex  sync.Mutex
+}
+
+func NewDescriptors(conn grpc.ClientConnInterface) *Descriptors {
+	return &Descriptors{
+		conn:   conn,
+		files:  &protoregistry.Files{},
+		protos: map[string]*descriptorpb.FileDescriptorProto{},
+	}
+}
+
+func (d *Descriptors) FindMethod(ctx context.Context, method string) (protoreflect.MethodDescriptor, error) {
+	serviceName, methodName, found := strings.Cut(method, "/")
+	if !found {
+		return nil, fmt.Errorf("invalid method name: %s", method)
+	}
+
+	descriptor, err := d.FindDescriptor(ctx, protoreflect.FullName(serviceName))
+	if err != nil {
+		return nil, err
+	}
+
+	service, ok := descriptor.(protoreflect.ServiceDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a service", serviceName)
+	}
+
+	methodDescriptor := service.Methods().ByName(protoreflect.Name(methodName))
+	if methodDescriptor == nil {
+		return nil, fmt.Errorf("method %s is not found in service %s", methodName, serviceName)
+	}
+
+	return methodDescriptor, nil
+}
+
+func (d *Descriptors) FindMessage(ctx context.Context, name protoreflect.FullName) (protoreflect.MessageDescriptor, error) {
+	descriptor, err := d.FindDescriptor(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	message, ok := descriptor.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a message", name)
+	}
+
+	return message, nil
+}
+
+func (d *Descriptors) FindDescriptor(ctx context.Context, name protoreflect.FullName) (protoreflect.Descriptor, error) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	descriptor, err := d.files.FindDescriptorByName(name)
+	if err == nil {
+		return descriptor, nil
+	}
+
+	protos, err := d.fetch(ctx, &reflectionPb.ServerReflectionRequest{
+		MessageRequest: &reflectionPb.ServerReflectionRequest_FileContainingSymbol{
+			FileContainingSymbol: string(name),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, fileProto := range protos {
+		err = d.register(ctx, fileProto.GetName())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return d.files.FindDescriptorByName(name)
+}
+
+func (d *Descriptors) register(ctx context.Context, filename string) error {
+	_, err := d.files.FindFileByPath(filename)
+	if err == nil {
+		return nil
+	}
+
+	fileProto, ok := d.protos[filename]
+	if !ok {
+		_, err = d.fetch(ctx, &reflectionPb.ServerReflectionRequest{
+			MessageRequest: &reflectionPb.ServerReflectionRequest_FileByFilename{
+				FileByFilename: filename,
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		fileProto, ok = d.protos[filename]
+		if !ok {
+			return fmt.Errorf("node did not return the descriptor for %s", filename)
+		}
+	}
+
+	// Options-only dependencies like gogoproto might be missing and are not needed.
+	for _, dependency := range fileProto.GetDependency() {
+		_ = d.register(ctx, dependency)
+	}
+
+	file, err := protodesc.FileOptions{AllowUnresolvable: true}.New(fileProto, d.files)
+	if err != nil {
+		return fmt.Errorf("could not build descriptor for %s: %w", filename, err)
+	}
+
+	return d.files.RegisterFile(file)
+}
+
+func (d *Descriptors) fetch(
+	ctx context.Context,
+	request *reflectionPb.ServerReflectionRequest,
+) ([]*descriptorpb.FileDescriptorProto, error) {
+	stream, err := reflectionPb.NewServerReflectionClient(d.conn).ServerReflectionInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer stream.CloseSend() //nolint:errcheck // nothing to do if closing the stream fails
+
+	err = stream.Send(request)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := stream.Recv()
+	if err != nil {
+		return nil, err
+	}
+
+	errorResponse := response.GetErrorResponse()
+	if errorResponse != nil {
+		return nil, errors.New(errorResponse.GetErrorMessage())
+	}
+
+	fileDescriptors := response.GetFileDescriptorResponse().GetFileDescriptorProto()
+	protos := make([]*descriptorpb.FileDescriptorProto, len(fileDescriptors))
+
+	for index, fileDescriptorBytes := range fileDescriptors {
+		fileProto := &descriptorpb.FileDescriptorProto{}
+
+		err = proto.Unmarshal(fileDescriptorBytes, fileProto)
+		if err != nil {
+			return nil, err
+		}
+
+		d.protos[fileProto.GetName()] = fileProto
+		protos[index] = fileProto
+	}
+
+	return protos, nil
+}
+
+func (d *Descriptors) Resolver(ctx context.Context) *Resolver {
+	return &Resolver{ctx: ctx, descriptors: d}
+}
+
+type Resolver struct {
+	ctx         context.Context //nolint:containedctx // protojson resolver interface has no context
+	descriptors *Descriptors
+}
+
+func (r *Resolver) FindMessageByName(name protoreflect.FullName) (protoreflect.MessageType, error) {
+	message, err := r.descriptors.FindMessage(r.ctx, name)
+	if err != nil {
+		return nil, protoregistry.NotFound
+	}
+
+	return dynamicpb.NewMessageType(message), nil
+}
+
+func (r *Resolver) FindMessageByURL(url string) (protoreflect.MessageType, error) {
+	name := url
+	if index := strings.LastIndex(url, "/"); index >= 0 {
+		name = url[index+1:]
+	}
+
+	return r.FindMessageByName(protoreflect.FullName(name))
+}
+
+func (r *Resolver) FindExtensionByName(field protoreflect.FullName) (protoreflect.ExtensionType, error) {
+	return nil, protoregistry.NotFound
+}
+
+func (r *Resolver) FindExtensionByNumber(
+	message protoreflect.FullName,
+	field protoreflect.FieldNumber,
+) (protoreflect.ExtensionType, error) {
+	return nil, protoregistry.NotFound
+}
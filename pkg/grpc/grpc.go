@@ -0,0 +1,397 @@
+package grpc
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"main/pkg/config"
+	"main/pkg/constants"
+	"main/pkg/ratelimit"
+	"main/pkg/types"
+	"main/pkg/utils"
+	"net"
+	neturl "net/url"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	grpcCodes "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	"github.com/rs/zerolog"
+)
+
+type QueryError struct {
+	Class constants.QueryErrorClass
+	Err   error
+}
+
+func (e *QueryError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *QueryError) Unwrap() error {
+	return e.Err
+}
+
+type Client struct {
+	logger      zerolog.Logger
+	chain       string
+	address     string
+	timeout     time.Duration
+	tracer      trace.Tracer
+	conn        *grpc.ClientConn
+	descriptors *Descriptors
+	limiter     *ratelimit.Limiter
+}
+
+func NewClient(
+	logger *zerolog.Logger,
+	chain string,
+	address string,
+	timeout time.Duration,
+	transportConfig config.TransportConfig,
+	rateLimitConfig config.RateLimitConfig,
+	tracer trace.Tracer,
+) (*Client, error) {
+	parsedURL, err := neturl.Parse(address)
+	if err != nil {
+		return nil, err
+	}
+
+	var transportCredentials credentials.TransportCredentials
+
+	port := parsedURL.Port()
+
+	switch parsedURL.Scheme {
+	case "https":
+		tlsConfig, err := transportConfig.GetTLSConfig()
+		if err != nil {
+			return nil, err
+		}
+
+		if tlsConfig == nil {
+			tlsConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+		}
+
+		transportCredentials = credentials.NewTLS(tlsConfig)
+
+		if port == "" {
+			port = "443"
+		}
+	case "http":
+		transportCredentials = insecure.NewCredentials()
+
+		if port == "" {
+			port = "9090"
+		}
+	default:
+		return nil, errors.New("gRPC endpoint should start with http:// or https://")
+	}
+
+	conn, err := grpc.NewClient(
+		net.JoinHostPort(parsedURL.Hostname(), port),
+		grpc.WithTransportCredentials(transportCredentials),
+		grpc.WithPerRPCCredentials(newMetadataCredentials(transportConfig)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if transportConfig.Timeout > 0 {
+		timeout = time.Duration(transportConfig.Timeout) * time.Second
+	}
+
+	return &Client{
+		logger: logger.With().
+			Str("component", "grpc").
+			Str("chain", chain).
+			Logger(),
+		chain:       chain,
+		address:     utils.RedactURL(address),
+		timeout:     timeout,
+		tracer:      tracer,
+		conn:        conn,
+		descriptors: NewDescriptors(conn),
+		limiter:     ratelimit.NewLimiter(rateLimitConfig),
+	}, nil
+}
+
+func (c *Client) Query(
+	ctx context.Context,
+	method string,
+	request map[string]any,
+	height int64,
+	minHeight int64,
+	target any,
+) (types.QueryInfo, int64, error) {
+	childCtx, span := c.tracer.Start(
+		ctx,
+		"gRPC request",
+		trace.WithAttributes(
+			attribute.String("chain", c.chain),
+			attribute.String("method", method),
+			attribute.Int64("height", height),
+		),
+	)
+	defer span.End()
+
+	start := time.Now()
+
+	queryInfo := types.QueryInfo{
+		Success:  false,
+		Chain:    c.chain,
+		URL:      c.address + "/" + method,
+		Attempts: 1,
+	}
+
+	c.logger.Debug().Str("method", method).Msg("Doing a query...")
+
+	var (
+		responseHeight int64
+		queryErr       *QueryError
+	)
+
+	release, queueWait, err := c.waitInQueue(childCtx)
+	queryInfo.QueueWait = queueWait
+
+	if err != nil {
+		queryErr = &QueryError{Class: classifyError(childCtx, err), Err: err}
+	} else {
+		responseHeight, queryErr = c.doQuery(childCtx, method, request, height, minHeight, target)
+		release()
+	}
+
+	queryInfo.Duration = time.Since(start)
+
+	span.SetAttributes(attribute.Int64("queue-wait-ms", queueWait.Milliseconds()))
+
+	if queryErr != nil {
+		queryInfo.ErrorClass = queryErr.Class
+
+		span.SetAttributes(attribute.String("error-class", string(queryErr.Class)))
+		span.RecordError(queryErr)
+		span.SetStatus(codes.Error, queryErr.Error())
+
+		c.logger.Warn().
+			Str("method", method).
+			Err(queryErr).
+			Str("class", string(queryErr.Class)).
+			Msg("Query failed")
+
+		return queryInfo, 0, queryErr
+	}
+
+	c.logger.Debug().
+		Str("method", method).
+		Int64("height", responseHeight).
+		Dur("duration", queryInfo.Duration).
+		Msg("Query is finished")
+
+	span.SetAttributes(attribute.Int64("response-height", responseHeight))
+
+	queryInfo.Success = true
+
+	return queryInfo, responseHeight, nil
+}
+
+func (c *Client) waitInQueue(ctx context.Context) (func(), time.Duration, error) {
+	if !c.limiter.Enabled() {
+		return func() {}, 0, nil
+	}
+
+	queueCtx, span := c.tracer.Start(ctx, "Waiting in queue")
+	defer span.End()
+
+	release, queueWait, err := c.limiter.Acquire(queueCtx, c.address)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return release, queueWait, err
+}
+
+func (c *Client) doQuery(
+	ctx context.Context,
+	method string,
+	request map[string]any,
+	height int64,
+	minHeight int64,
+	target any,
+) (int64, *QueryError) {
+	timeoutCtx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	methodDescriptor, err := c.descriptors.FindMethod(timeoutCtx, method)
+	if err != nil {
+		return 0, &QueryError{Class: classifyError(timeoutCtx, err), Err: err}
+	}
+
+	resolver := c.descriptors.Resolver(timeoutCtx)
+
+	requestBytes, err := json.Marshal(request)
+	if err != nil {
+		return 0, &QueryError{Class: constants.QueryErrorClassRequest, Err: err}
+	}
+
+	requestMessage := dynamicpb.NewMessage(methodDescriptor.Input())
+
+	// Older versions of the modules might not have some of the request fields.
+	err = protojson.UnmarshalOptions{Resolver: resolver, DiscardUnknown: true}.Unmarshal(requestBytes, requestMessage)
+	if err != nil {
+		return 0, &QueryError{Class: constants.QueryErrorClassRequest, Err: err}
+	}
+
+	responseMessage := dynamicpb.NewMessage(methodDescriptor.Output())
+
+	invokeCtx := timeoutCtx
+	if height > 0 {
+		invokeCtx = metadata.AppendToOutgoingContext(
+			timeoutCtx,
+			constants.GRPCMetadataBlockHeight,
+			strconv.FormatInt(height, 10),
+		)
+	}
+
+	var header metadata.MD
+
+	err = c.conn.Invoke(
+		invokeCtx,
+		"/"+method,
+		requestMessage,
+		responseMessage,
+		grpc.Header(&header),
+	)
+	if err != nil {
+		return 0, &QueryError{Class: classifyError(timeoutCtx, err), Err: err}
+	}
+
+	responseHeight, err := getHeight(header)
+	if err != nil {
+		return 0, &QueryError{Class: constants.QueryErrorClassDecode, Err: err}
+	}
+
+	if responseHeight < minHeight {
+		return responseHeight, &QueryError{
+			Class: constants.QueryErrorClassStaleHeight,
+			Err:   fmt.Errorf("previous height (%d) is bigger than the current height (%d)", minHeight, responseHeight),
+		}
+	}
+
+	err = c.decodeResponse(methodDescriptor.Output(), responseMessage, resolver, target)
+	if err != nil {
+		return 0, &QueryError{Class: constants.QueryErrorClassDecode, Err: err}
+	}
+
+	return responseHeight, nil
+}
+
+func (c *Client) decodeResponse(
+	descriptor protoreflect.MessageDescriptor,
+	response *dynamicpb.Message,
+	resolver *Resolver,
+	target any,
+) error {
+	responseBytes, err := protojson.MarshalOptions{
+		UseProtoNames:   true,
+		EmitUnpopulated: true,
+		Resolver:        resolver,
+	}.Marshal(response)
+	if err != nil {
+		return err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(responseBytes))
+	decoder.UseNumber()
+
+	var value map[string]any
+
+	err = decoder.Decode(&value)
+	if err != nil {
+		return err
+	}
+
+	fixDecimals(descriptor, value, func(url string) protoreflect.MessageDescriptor {
+		messageType, err := resolver.FindMessageByURL(url)
+		if err != nil {
+			return nil
+		}
+
+		return messageType.Descriptor()
+	})
+
+	responseBytes, err = json.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(responseBytes, target)
+}
+
+func getHeight(header metadata.MD) (int64, error) {
+	values := header.Get(constants.GRPCMetadataBlockHeight)
+	if len(values) == 0 {
+		return 0, nil
+	}
+
+	return strconv.ParseInt(values[0], 10, 64)
+}
+
+func classifyError(ctx context.Context, err error) constants.QueryErrorClass {
+	if errors.Is(ctx.Err(), context.Canceled) {
+		return constants.QueryErrorClassCanceled
+	}
+
+	grpcStatus, ok := status.FromError(err)
+	if !ok {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return constants.QueryErrorClassTimeout
+		}
+
+		return constants.QueryErrorClassRequest
+	}
+
+	switch grpcStatus.Code() {
+	case grpcCodes.DeadlineExceeded:
+		return constants.QueryErrorClassTimeout
+	case grpcCodes.Canceled:
+		return constants.QueryErrorClassCanceled
+	case grpcCodes.Unavailable:
+		return constants.QueryErrorClassConnection
+	case grpcCodes.ResourceExhausted:
+		return constants.QueryErrorClassRateLimited
+	case grpcCodes.NotFound,
+		grpcCodes.InvalidArgument,
+		grpcCodes.FailedPrecondition,
+		grpcCodes.OutOfRange,
+		grpcCodes.AlreadyExists:
+		return constants.QueryErrorClassResponseCode
+	case grpcCodes.Unimplemented,
+		grpcCodes.PermissionDenied,
+		grpcCodes.Unauthenticated:
+		return constants.QueryErrorClassClientError
+	default:
+		return constants.QueryErrorClassServerError
+	}
+}
+
+func (c *Client) Address() string {
+	return c.address
+}
+
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
@@ -0,0 +1,360 @@
+package grpc
+
+import (
+	"context"
+	"encoding/json"
+	"main/pkg/config"
+	"main/pkg/constants"
+	"main/pkg/logger"
+	"main/pkg/tracing"
+	"main/pkg/types"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	grpcCodes "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/reflection"
+	reflectionPb "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+const commissionMethod = "cosmos.distribution.v1beta1.Query/ValidatorCommission"
+
+// getTestFiles marks the DecCoin amount as a decimal, same as in the SDK.
+func getTestFiles(t *testing.T) *protoregistry.Files {
+	t.Helper()
+
+	decOptions := &descriptorpb.FieldOptions{}
+	decOptions.ProtoReflect().SetUnknown(
+		protowire.AppendString(
+			protowire.AppendTag(nil, gogoprotoCustomTypeOption, protowire.BytesType),
+			"cosmossdk.io/math.LegacyDec",
+		),
+	)
+
+	field := func(name string, number int32, fieldType descriptorpb.FieldDescriptorProto_Type) *descriptorpb.FieldDescriptorProto {
+		return &descriptorpb.FieldDescriptorProto{
+			Name:     proto.String(name),
+			JsonName: proto.String(name),
+			Number:   proto.Int32(number),
+			Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+			Type:     fieldType.Enum(),
+		}
+	}
+
+	amountField := field("amount", 2, descriptorpb.FieldDescriptorProto_TYPE_STRING)
+	amountField.Options = decOptions
+
+	commissionField := field("commission", 1, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE)
+	commissionField.Label = descriptorpb.FieldDescriptorProto_LABEL_REPEATED.Enum()
+	commissionField.TypeName = proto.String(".cosmos.distribution.v1beta1.DecCoin")
+
+	responseField := field("commission", 1, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE)
+	responseField.TypeName = proto.String(".cosmos.distribution.v1beta1.ValidatorAccumulatedCommission")
+
+	fileProto := &descriptorpb.FileDescriptorProto{
+		Name:    proto.String("cosmos/distribution/v1beta1/query.proto"),
+		Package: proto.String("cosmos.distribution.v1beta1"),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{
+			{
+				Name: proto.String("DecCoin"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					field("denom", 1, descriptorpb.FieldDescriptorProto_TYPE_STRING),
+					amountField,
+				},
+			},
+			{
+				Name:  proto.String("ValidatorAccumulatedCommission"),
+				Field: []*descriptorpb.FieldDescriptorProto{commissionField},
+			},
+			{
+				Name: proto.String("QueryValidatorCommissionRequest"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					field("validator_address", 1, descriptorpb.FieldDescriptorProto_TYPE_STRING),
+				},
+			},
+			{
+				Name:  proto.String("QueryValidatorCommissionResponse"),
+				Field: []*descriptorpb.FieldDescriptorProto{responseField},
+			},
+		},
+		Service: []*descriptorpb.ServiceDescriptorProto{
+			{
+				Name: proto.String("Query"),
+				Method: []*descriptorpb.MethodDescriptorProto{
+					{
+						Name:       proto.String("ValidatorCommission"),
+						InputType:  proto.String(".cosmos.distribution.v1beta1.QueryValidatorCommissionRequest"),
+						OutputType: proto.String(".cosmos.distribution.v1beta1.QueryValidatorCommissionResponse"),
+					},
+				},
+			},
+		},
+	}
+
+	file, err := protodesc.NewFile(fileProto, nil)
+	require.NoError(t, err)
+
+	files := &protoregistry.Files{}
+	require.NoError(t, files.RegisterFile(file))
+
+	return files
+}
+
+func startTestServer(
+	t *testing.T,
+	handler func(request map[string]any, md metadata.MD) (string, string, error),
+) string {
+	t.Helper()
+
+	files := getTestFiles(t)
+
+	serviceDescriptor, err := files.FindDescriptorByName("cosmos.distribution.v1beta1.Query")
+	require.NoError(t, err)
+
+	service, ok := serviceDescriptor.(protoreflect.ServiceDescriptor)
+	require.True(t, ok)
+
+	methodDescriptor := service.Methods().ByName("ValidatorCommission")
+
+	server := grpc.NewServer(grpc.UnknownServiceHandler(func(srv any, stream grpc.ServerStream) error {
+		method, _ := grpc.MethodFromServerStream(stream)
+		if method != "/"+commissionMethod {
+			return status.Error(grpcCodes.Unimplemented, "unknown method")
+		}
+
+		request := dynamicpb.NewMessage(methodDescriptor.Input())
+
+		err := stream.RecvMsg(request)
+		if err != nil {
+			return err
+		}
+
+		requestBytes, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(request)
+		if err != nil {
+			return err
+		}
+
+		var requestValue map[string]any
+
+		err = json.Unmarshal(requestBytes, &requestValue)
+		if err != nil {
+			return err
+		}
+
+		md, _ := metadata.FromIncomingContext(stream.Context())
+
+		responseJSON, height, err := handler(requestValue, md)
+		if err != nil {
+			return err
+		}
+
+		response := dynamicpb.NewMessage(methodDescriptor.Output())
+
+		err = protojson.Unmarshal([]byte(responseJSON), response)
+		if err != nil {
+			return err
+		}
+
+		err = stream.SetHeader(metadata.Pairs(constants.GRPCMetadataBlockHeight, height))
+		if err != nil {
+			return err
+		}
+
+		return stream.SendMsg(response)
+	}))
+
+	reflectionPb.RegisterServerReflectionServer(server, reflection.NewServer(reflection.ServerOptions{
+		Services:           server,
+		DescriptorResolver: files,
+	}))
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	go server.Serve(listener) //nolint:errcheck // stopped at the end of the test
+
+	t.Cleanup(server.Stop)
+
+	return "http://" + listener.Addr().String()
+}
+
+func newTestClient(t *testing.T, address string, transportConfig config.TransportConfig) *Client {
+	t.Helper()
+
+	client, err := NewClient(
+		logger.GetNopLogger(),
+		"chain",
+		address,
+		5*time.Second,
+		transportConfig,
+		config.RateLimitConfig{},
+		tracing.InitNoopTracer(),
+	)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+
+	return client
+}
+
+func TestNewClientInvalidScheme(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewClient(
+		logger.GetNopLogger(),
+		"chain",
+		"localhost:9090",
+		5*time.Second,
+		config.TransportConfig{},
+		config.RateLimitConfig{},
+		tracing.InitNoopTracer(),
+	)
+	require.Error(t, err)
+}
+
+func TestClientQuerySuccess(t *testing.T) {
+	t.Parallel()
+
+	address := startTestServer(t, func(request map[string]any, md metadata.MD) (string, string, error) {
+		assert.Equal(t, "cosmosvaloper1xqz9pemz5e5zycaa89kys5aw6m8rhgsvw4328e", request["validator_address"])
+		assert.Equal(t, []string{"Bearer token"}, md.Get("authorization"))
+		assert.Equal(t, []string{"value"}, md.Get("x-custom-header"))
+
+		return `{"commission":{"commission":[{"denom":"uatom","amount":"1234500000000000000000"}]}}`, "100", nil
+	})
+
+	client := newTestClient(t, address, config.TransportConfig{
+		BearerToken: "token",
+		Headers:     map[string]string{"X-Custom-Header": "value"},
+	})
+
+	var response types.CommissionResponse
+
+	info, height, err := client.Query(
+		context.Background(),
+		commissionMethod,
+		map[string]any{"validator_address": "cosmosvaloper1xqz9pemz5e5zycaa89kys5aw6m8rhgsvw4328e"},
+		0,
+		0,
+		&response,
+	)
+	require.NoError(t, err)
+	assert.True(t, info.Success)
+	assert.Equal(t, address+"/"+commissionMethod, info.URL)
+	assert.Equal(t, int64(100), height)
+	require.Len(t, response.Commission.Commission, 1)
+	assert.Equal(t, "uatom", response.Commission.Commission[0].Denom)
+	assert.InDelta(t, 1234.5, response.Commission.Commission[0].ToAmount().Amount, 0.01)
+}
+
+func TestClientQueryAtHeight(t *testing.T) {
+	t.Parallel()
+
+	address := startTestServer(t, func(request map[string]any, md metadata.MD) (string, string, error) {
+		assert.Equal(t, []string{"50"}, md.Get(constants.GRPCMetadataBlockHeight))
+		return `{"commission":{"commission":[]}}`, "50", nil
+	})
+
+	client := newTestClient(t, address, config.TransportConfig{})
+
+	var response types.CommissionResponse
+
+	info, height, err := client.Query(context.Background(), commissionMethod, map[string]any{}, 50, 50, &response)
+	require.NoError(t, err)
+	assert.True(t, info.Success)
+	assert.Equal(t, int64(50), height)
+}
+
+func TestClientQueryStaleHeight(t *testing.T) {
+	t.Parallel()
+
+	address := startTestServer(t, func(request map[string]any, md metadata.MD) (string, string, error) {
+		return `{"commission":{"commission":[{"denom":"uatom","amount":"1"}]}}`, "100", nil
+	})
+
+	client := newTestClient(t, address, config.TransportConfig{})
+
+	var response types.CommissionResponse
+
+	info, _, err := client.Query(context.Background(), commissionMethod, map[string]any{}, 0, 200, &response)
+	require.Error(t, err)
+	assert.False(t, info.Success)
+	assert.Equal(t, constants.QueryErrorClassStaleHeight, info.ErrorClass)
+	assert.Empty(t, response.Commission.Commission)
+}
+
+func TestClientQueryAppError(t *testing.T) {
+	t.Parallel()
+
+	address := startTestServer(t, func(request map[string]any, md metadata.MD) (string, string, error) {
+		return "", "", status.Error(grpcCodes.NotFound, "validator does not exist")
+	})
+
+	client := newTestClient(t, address, config.TransportConfig{})
+
+	var response types.CommissionResponse
+
+	info, _, err := client.Query(context.Background(), commissionMethod, map[string]any{}, 0, 0, &response)
+	require.Error(t, err)
+	assert.False(t, info.Success)
+	assert.Equal(t, constants.QueryErrorClassResponseCode, info.ErrorClass)
+}
+
+func TestClientQueryUnknownMethod(t *testing.T) {
+	t.Parallel()
+
+	address := startTestServer(t, func(request map[string]any, md metadata.MD) (string, string, error) {
+		return "{}", "100", nil
+	})
+
+	client := newTestClient(t, address, config.TransportConfig{})
+
+	var response types.CommissionResponse
+
+	info, _, err := client.Query(
+		context.Background(),
+		"cosmos.distribution.v1beta1.Query/Unknown",
+		map[string]any{},
+		0,
+		0,
+		&response,
+	)
+	require.Error(t, err)
+	assert.False(t, info.Success)
+	assert.Equal(t, constants.QueryErrorClassRequest, info.ErrorClass)
+}
+
+func TestClientQueryUnavailable(t *testing.T) {
+	t.Parallel()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	address := "http://" + listener.Addr().String()
+	require.NoError(t, listener.Close())
+
+	client := newTestClient(t, address, config.TransportConfig{})
+
+	var response types.CommissionResponse
+
+	info, _, err := client.Query(context.Background(), commissionMethod, map[string]any{}, 0, 0, &response)
+	require.Error(t, err)
+	assert.False(t, info.Success)
+	assert.Equal(t, constants.QueryErrorClassConnection, info.ErrorClass)
+}
@@ -3,11 +3,24 @@ package http
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"main/pkg/config"
+	"main/pkg/constants"
+	"main/pkg/ratelimit"
 	"main/pkg/types"
+	"main/pkg/utils"
+	"math/rand/v2"
+	"net"
 	"net/http"
+	neturl "net/url"
+	"strconv"
 	"time"
 
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/trace"
 
 	"github.com/rs/zerolog"
@@ -17,79 +30,452 @@ type Client struct {
 	logger     zerolog.Logger
 	chain      string
 	tracer     trace.Tracer
+	retries    config.RetriesConfig
+	transport  config.TransportConfig
+	limiter    *ratelimit.Limiter
 	httpClient *http.Client
 }
 
-func NewClient(logger *zerolog.Logger, chain string, tracer trace.Tracer) *Client {
+type QueryError struct {
+	Class constants.QueryErrorClass
+	Err   error
+	Code  int
+}
+
+func (e *QueryError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *QueryError) Unwrap() error {
+	return e.Err
+}
+
+func (e *QueryError) Retryable() bool {
+	switch e.Class {
+	case constants.QueryErrorClassTimeout,
+		constants.QueryErrorClassConnection,
+		constants.QueryErrorClassRateLimited,
+		constants.QueryErrorClassServerError:
+		return true
+	default:
+		return false
+	}
+}
+
+func NewClient(
+	logger *zerolog.Logger,
+	chain string,
+	timeout time.Duration,
+	retries config.RetriesConfig,
+	transportConfig config.TransportConfig,
+	rateLimitConfig config.RateLimitConfig,
+	tracer trace.Tracer,
+) *Client {
+	clientLogger := logger.With().
+		Str("component", "http").
+		Str("chain", chain).
+		Logger()
+
 	var transport http.RoundTripper
 
 	transportRaw, ok := http.DefaultTransport.(*http.Transport)
 	if ok {
-		transport = transportRaw.Clone()
+		transportClone := transportRaw.Clone()
+		err := applyTransportConfig(transportClone, transportConfig)
+		if err != nil {
+			clientLogger.Panic().Err(err).Msg("Could not apply transport config")
+		}
+
+		transport = transportClone
 	} else {
 		transport = http.DefaultTransport
 	}
 
+	if transportConfig.Timeout > 0 {
+		timeout = time.Duration(transportConfig.Timeout) * time.Second
+	}
+
 	return &Client{
-		logger: logger.With().
-			Str("component", "http").
-			Str("chain", chain).
-			Logger(),
-		chain:  chain,
-		tracer: tracer,
+		logger:    clientLogger,
+		chain:     chain,
+		tracer:    tracer,
+		retries:   retries,
+		transport: transportConfig,
+		limiter:   ratelimit.NewLimiter(rateLimitConfig),
 		httpClient: &http.Client{
-			Timeout:   10 * time.Second,
+			Timeout:   timeout,
 			Transport: otelhttp.NewTransport(transport),
 		},
 	}
 }
 
+func applyTransportConfig(transport *http.Transport, transportConfig config.TransportConfig) error {
+	tlsConfig, err := transportConfig.GetTLSConfig()
+	if err != nil {
+		return err
+	}
+
+	if tlsConfig != nil {
+		transport.TLSClientConfig = tlsConfig
+	}
+
+	proxyURL, err := transportConfig.GetProxyURL()
+	if err != nil {
+		return err
+	}
+
+	if proxyURL != nil {
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	if transportConfig.DialTimeout > 0 {
+		dialer := &net.Dialer{
+			Timeout:   time.Duration(transportConfig.DialTimeout) * time.Second,
+			KeepAlive: 30 * time.Second,
+		}
+		transport.DialContext = dialer.DialContext
+	}
+
+	if transportConfig.TLSHandshakeTimeout > 0 {
+		transport.TLSHandshakeTimeout = time.Duration(transportConfig.TLSHandshakeTimeout) * time.Second
+	}
+
+	if transportConfig.ResponseHeaderTimeout > 0 {
+		transport.ResponseHeaderTimeout = time.Duration(transportConfig.ResponseHeaderTimeout) * time.Second
+	}
+
+	if transportConfig.IdleConnTimeout > 0 {
+		transport.IdleConnTimeout = time.Duration(transportConfig.IdleConnTimeout) * time.Second
+	}
+
+	if transportConfig.MaxIdleConns > 0 {
+		transport.MaxIdleConns = transportConfig.MaxIdleConns
+	}
+
+	if transportConfig.MaxIdleConnsPerHost > 0 {
+		transport.MaxIdleConnsPerHost = transportConfig.MaxIdleConnsPerHost
+	}
+
+	if transportConfig.MaxConnsPerHost > 0 {
+		transport.MaxConnsPerHost = transportConfig.MaxConnsPerHost
+	}
+
+	return nil
+}
+
 func (c *Client) Get(
 	url string,
 	target any,
 	predicate types.HTTPPredicate,
 	ctx context.Context,
 ) (types.QueryInfo, http.Header, error) {
-	childCtx, span := c.tracer.Start(ctx, "HTTP request")
+	return c.GetAtHeight(url, 0, target, predicate, ctx)
+}
+
+func (c *Client) GetAtHeight(
+	url string,
+	height int64,
+	target any,
+	predicate types.HTTPPredicate,
+	ctx context.Context,
+) (types.QueryInfo, http.Header, error) {
+	childCtx, span := c.tracer.Start(
+		ctx,
+		"HTTP request",
+		trace.WithAttributes(
+			attribute.String("chain", c.chain),
+			attribute.Int64("height", height),
+		),
+	)
 	defer span.End()
 
 	start := time.Now()
 
+	redactedURL := utils.RedactURL(url)
+
 	queryInfo := types.QueryInfo{
 		Success: false,
 		Chain:   c.chain,
-		URL:     url,
+		URL:     redactedURL,
 	}
 
-	req, err := http.NewRequestWithContext(childCtx, http.MethodGet, url, nil)
+	var (
+		header     http.Header
+		retryAfter time.Duration
+		queryErr   *QueryError
+	)
+
+	for {
+		queryInfo.Attempts++
+
+		release, queueWait, err := c.waitInQueue(childCtx, url)
+		queryInfo.QueueWait += queueWait
+
+		if err != nil {
+			queryErr = &QueryError{Class: classifyError(childCtx, err), Err: err}
+			queryInfo.ErrorClass = queryErr.Class
+			break
+		}
+
+		header, queryInfo.StatusCode, retryAfter, queryErr = c.doRequest(childCtx, url, redactedURL, height, target, predicate)
+		release()
+
+		if queryErr == nil {
+			break
+		}
+
+		queryInfo.ErrorClass = queryErr.Class
+
+		if !queryErr.Retryable() || queryInfo.Attempts > c.retries.MaxRetries || childCtx.Err() != nil {
+			break
+		}
+
+		// Respecting Retry-After, but not waiting longer than configured.
+		maxBackoff := time.Duration(c.retries.MaxBackoffMs) * time.Millisecond
+		backoff := max(c.getBackoff(queryInfo.Attempts), min(retryAfter, maxBackoff))
+
+		c.logger.Debug().
+			Str("url", redactedURL).
+			Err(queryErr).
+			Str("class", string(queryErr.Class)).
+			Int("attempt", queryInfo.Attempts).
+			Dur("backoff", backoff).
+			Msg("Query failed, retrying")
+
+		select {
+		case <-time.After(backoff):
+		case <-childCtx.Done():
+		}
+	}
+
+	queryInfo.Duration = time.Since(start)
+
+	span.SetAttributes(
+		attribute.Int("attempts", queryInfo.Attempts),
+		attribute.Int("status", queryInfo.StatusCode),
+		attribute.Int64("queue-wait-ms", queryInfo.QueueWait.Milliseconds()),
+	)
+
+	if queryErr != nil {
+		span.SetAttributes(attribute.String("error-class", string(queryErr.Class)))
+		span.RecordError(queryErr)
+		span.SetStatus(codes.Error, queryErr.Error())
+
+		c.logger.Warn().
+			Str("url", redactedURL).
+			Err(queryErr).
+			Str("class", string(queryErr.Class)).
+			Int("attempts", queryInfo.Attempts).
+			Msg("Query failed")
+
+		return queryInfo, header, queryErr
+	}
+
+	queryInfo.ErrorClass = constants.QueryErrorClassNone
+	queryInfo.Success = true
+
+	return queryInfo, header, nil
+}
+
+func (c *Client) waitInQueue(ctx context.Context, url string) (func(), time.Duration, error) {
+	if !c.limiter.Enabled() {
+		return func() {}, 0, nil
+	}
+
+	host := url
+	if parsedURL, err := neturl.Parse(url); err == nil {
+		host = parsedURL.Host
+	}
+
+	queueCtx, span := c.tracer.Start(
+		ctx,
+		"Waiting in queue",
+		trace.WithAttributes(attribute.String("host", host)),
+	)
+	defer span.End()
+
+	release, queueWait, err := c.limiter.Acquire(queueCtx, host)
 	if err != nil {
 		span.RecordError(err)
-		return queryInfo, nil, err
+		span.SetStatus(codes.Error, err.Error())
+		return nil, queueWait, err
+	}
+
+	span.SetAttributes(attribute.Int64("queue-wait-ms", queueWait.Milliseconds()))
+
+	return release, queueWait, nil
+}
+
+func (c *Client) doRequest(
+	ctx context.Context,
+	url string,
+	redactedURL string,
+	height int64,
+	target any,
+	predicate types.HTTPPredicate,
+) (http.Header, int, time.Duration, *QueryError) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, 0, 0, &QueryError{Class: constants.QueryErrorClassRequest, Err: err}
 	}
 
 	req.Header.Set("User-Agent", "cosmos-validators-exporter")
 
-	c.logger.Debug().Str("url", url).Msg("Doing a query...")
+	if height > 0 {
+		req.Header.Set(constants.GRPCMetadataBlockHeight, strconv.FormatInt(height, 10))
+	}
 
-	res, err := c.httpClient.Do(req)
-	queryInfo.Duration = time.Since(start)
+	for name, value := range c.transport.Headers {
+		req.Header.Set(name, value)
+	}
+
+	if c.transport.BasicAuthUser != "" {
+		req.SetBasicAuth(c.transport.BasicAuthUser, c.transport.BasicAuthPassword)
+	}
+
+	if c.transport.BearerToken != "" {
+		req.Header.Set("Authorization", "Bearer "+c.transport.BearerToken)
+	}
+
+	c.logger.Debug().Str("url", redactedURL).Msg("Doing a query...")
+
+	start := time.Now()
 
+	res, err := c.httpClient.Do(req)
 	if err != nil {
-		c.logger.Warn().Str("url", url).Err(err).Msg("Query failed")
-		return queryInfo, nil, err
+		// the error message contains the URL, so it should not have secrets as well
+		var urlErr *neturl.Error
+		if errors.As(err, &urlErr) {
+			urlErr.URL = redactedURL
+		}
+
+		return nil, 0, 0, &QueryError{Class: classifyError(ctx, err), Err: err}
 	}
 
 	defer res.Body.Close()
 
-	c.logger.Debug().Str("url", url).Dur("duration", time.Since(start)).Msg("Query is finished")
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return res.Header, res.StatusCode, 0, &QueryError{Class: classifyError(ctx, err), Err: err}
+	}
+
+	c.logger.Debug().
+		Str("url", redactedURL).
+		Int("status", res.StatusCode).
+		Dur("duration", time.Since(start)).
+		Msg("Query is finished")
+
+	statusErr := checkResponse(res, body)
+	if statusErr != nil {
+		return res.Header, res.StatusCode, getRetryAfter(res.Header), statusErr
+	}
 
 	predicateErr := predicate(res)
 	if predicateErr != nil {
-		return queryInfo, res.Header, predicateErr
+		return res.Header, res.StatusCode, 0, &QueryError{
+			Class: constants.QueryErrorClassStaleHeight,
+			Err:   predicateErr,
+		}
+	}
+
+	err = json.Unmarshal(body, target)
+	if err != nil {
+		return res.Header, res.StatusCode, 0, &QueryError{Class: constants.QueryErrorClassDecode, Err: err}
+	}
+
+	return res.Header, res.StatusCode, 0, nil
+}
+
+func (c *Client) getBackoff(attempt int) time.Duration {
+	backoff := time.Duration(c.retries.BackoffMs) * time.Millisecond
+	maxBackoff := time.Duration(c.retries.MaxBackoffMs) * time.Millisecond
+
+	for i := 1; i < attempt && backoff < maxBackoff; i++ {
+		backoff *= 2
+	}
+
+	backoff = min(backoff, maxBackoff)
+	if backoff <= 0 {
+		return 0
+	}
+
+	return backoff/2 + rand.N(backoff/2+1)
+}
+
+func checkResponse(res *http.Response, body []byte) *QueryError {
+	switch res.StatusCode {
+	case http.StatusTooManyRequests:
+		return &QueryError{
+			Class: constants.QueryErrorClassRateLimited,
+			Err:   fmt.Errorf("got rate limited: %s", res.Status),
+		}
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return &QueryError{
+			Class: constants.QueryErrorClassServerError,
+			Err:   fmt.Errorf("node is unavailable: %s", res.Status),
+		}
 	}
 
-	err = json.NewDecoder(res.Body).Decode(target)
-	queryInfo.Success = err == nil
+	// App errors, from Cosmos SDK or CometBFT RPC, would not change on retry, even with a 5xx status.
+	var errorResponse struct {
+		Code    int             `json:"code"`
+		Message string          `json:"message"`
+		Error   json.RawMessage `json:"error"`
+	}
+
+	if err := json.Unmarshal(body, &errorResponse); err == nil {
+		if errorResponse.Code != 0 {
+			return &QueryError{
+				Class: constants.QueryErrorClassResponseCode,
+				Err:   fmt.Errorf("expected code 0, but got %d: %s", errorResponse.Code, errorResponse.Message),
+				Code:  errorResponse.Code,
+			}
+		}
+
+		var rpcError types.CometRPCError
+		if len(errorResponse.Error) > 0 && json.Unmarshal(errorResponse.Error, &rpcError) == nil && rpcError.Code != 0 {
+			return &QueryError{
+				Class: constants.QueryErrorClassResponseCode,
+				Err:   &rpcError,
+			}
+		}
+	}
+
+	switch {
+	case res.StatusCode >= http.StatusInternalServerError:
+		return &QueryError{
+			Class: constants.QueryErrorClassServerError,
+			Err:   fmt.Errorf("got server error: %s", res.Status),
+		}
+	case res.StatusCode >= http.StatusBadRequest:
+		return &QueryError{
+			Class: constants.QueryErrorClassClientError,
+			Err:   fmt.Errorf("got client error: %s", res.Status),
+		}
+	}
+
+	return nil
+}
+
+func classifyError(ctx context.Context, err error) constants.QueryErrorClass {
+	// If the whole fetch was cancelled or timed out, there's no point in retrying.
+	if ctx.Err() != nil {
+		return constants.QueryErrorClassCanceled
+	}
+
+	var netErr net.Error
+	if errors.Is(err, context.DeadlineExceeded) || (errors.As(err, &netErr) && netErr.Timeout()) {
+		return constants.QueryErrorClassTimeout
+	}
+
+	return constants.QueryErrorClassConnection
+}
+
+func getRetryAfter(header http.Header) time.Duration {
+	seconds, err := strconv.Atoi(header.Get("Retry-After"))
+	if err != nil || seconds < 0 {
+		return 0
+	}
 
-	return queryInfo, res.Header, err
+	return time.Duration(seconds) * time.Second
 }
@@ -0,0 +1,35 @@
+package pkg
+
+import (
+	"main/pkg/constants"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type PinnedHeightsMetrics struct {
+	PinnedHeights map[string]int64
+}
+
+func NewPinnedHeightsMetrics(pinnedHeights map[string]int64) *PinnedHeightsMetrics {
+	return &PinnedHeightsMetrics{
+		PinnedHeights: pinnedHeights,
+	}
+}
+
+func (m *PinnedHeightsMetrics) GetMetrics() []prometheus.Collector {
+	pinnedHeightGauge := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: constants.MetricsPrefix + "pinned_height",
+			Help: "The block height all the queries to the chain were done at during the last fetch",
+		},
+		[]string{"chain"},
+	)
+
+	for chain, height := range m.PinnedHeights {
+		pinnedHeightGauge.With(prometheus.Labels{
+			"chain": chain,
+		}).Set(float64(height))
+	}
+
+	return []prometheus.Collector{pinnedHeightGauge}
+}
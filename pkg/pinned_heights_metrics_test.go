@@ -0,0 +1,36 @@
+package pkg
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPinnedHeightsMetrics(t *testing.T) {
+	t.Parallel()
+
+	generator := NewPinnedHeightsMetrics(map[string]int64{"chain": 123})
+	metrics := generator.GetMetrics()
+	assert.Len(t, metrics, 1)
+
+	pinnedHeightGauge, ok := metrics[0].(*prometheus.GaugeVec)
+	assert.True(t, ok)
+	assert.Equal(t, 1, testutil.CollectAndCount(pinnedHeightGauge))
+	assert.InDelta(t, 123, testutil.ToFloat64(pinnedHeightGauge.With(prometheus.Labels{
+		"chain": "chain",
+	})), 0.01)
+}
+
+func TestPinnedHeightsMetricsEmpty(t *testing.T) {
+	t.Parallel()
+
+	generator := NewPinnedHeightsMetrics(nil)
+	metrics := generator.GetMetrics()
+	assert.Len(t, metrics, 1)
+
+	pinnedHeightGauge, ok := metrics[0].(*prometheus.GaugeVec)
+	assert.True(t, ok)
+	assert.Zero(t, testutil.CollectAndCount(pinnedHeightGauge))
+}
@@ -10,6 +10,7 @@ import (
 	"main/pkg/types"
 	"main/pkg/utils"
 	"strings"
+	"time"
 
 	"go.opentelemetry.io/otel/trace"
 
@@ -32,7 +33,15 @@ func NewCoingecko(
 ) *Coingecko {
 	return &Coingecko{
 		Config: appConfig,
-		Client: http.NewClient(logger, "coingecko", tracer),
+		Client: http.NewClient(
+			logger,
+			"coingecko",
+			time.Duration(appConfig.Timeout)*time.Second,
+			config.RetriesConfig{},
+			config.TransportConfig{},
+			config.RateLimitConfig{},
+			tracer,
+		),
 		Logger: logger.With().Str("component", "coingecko").Logger(),
 		Tracer: tracer,
 	}
@@ -3,7 +3,9 @@ package pkg
 import (
 	"context"
 	"main/pkg/config"
+	"main/pkg/constants"
 	"main/pkg/types"
+	"main/pkg/utils"
 
 	"github.com/prometheus/client_golang/prometheus"
 )
@@ -53,6 +55,30 @@ func (q *QueriesMetrics) GetMetrics(ctx context.Context) []prometheus.Collector
 		[]string{"chain", "url"},
 	)
 
+	queriesErrorsByClassGauge := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "cosmos_validators_exporter_queries_error_by_class",
+			Help: "Failed queries count for this chain, by the reason they failed",
+		},
+		[]string{"chain", "class"},
+	)
+
+	retriesGauge := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "cosmos_validators_exporter_queries_retries",
+			Help: "Retries done for the queries for this chain",
+		},
+		[]string{"chain"},
+	)
+
+	queueWaitGauge := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "cosmos_validators_exporter_queries_queue_wait_seconds",
+			Help: "Total time the queries for this chain have waited for the rate limits",
+		},
+		[]string{"chain"},
+	)
+
 	// so we would have this metrics even if there are no requests
 	for _, chain := range q.Chains {
 		queriesCountGauge.With(prometheus.Labels{
@@ -66,6 +92,14 @@ func (q *QueriesMetrics) GetMetrics(ctx context.Context) []prometheus.Collector
 		queriesFailedGauge.With(prometheus.Labels{
 			"chain": chain.Name,
 		}).Set(0)
+
+		retriesGauge.With(prometheus.Labels{
+			"chain": chain.Name,
+		}).Set(0)
+
+		queueWaitGauge.With(prometheus.Labels{
+			"chain": chain.Name,
+		}).Set(0)
 	}
 
 	for _, query := range q.Infos {
@@ -75,9 +109,21 @@ func (q *QueriesMetrics) GetMetrics(ctx context.Context) []prometheus.Collector
 
 		timingsGauge.With(prometheus.Labels{
 			"chain": query.Chain,
-			"url":   query.URL,
+			"url":   utils.StripQuery(query.URL),
 		}).Set(query.Duration.Seconds())
 
+		if query.Attempts > 1 {
+			retriesGauge.With(prometheus.Labels{
+				"chain": query.Chain,
+			}).Add(float64(query.Attempts - 1))
+		}
+
+		if query.QueueWait > 0 {
+			queueWaitGauge.With(prometheus.Labels{
+				"chain": query.Chain,
+			}).Add(query.QueueWait.Seconds())
+		}
+
 		if query.Success {
 			queriesSuccessfulGauge.With(prometheus.Labels{
 				"chain": query.Chain,
@@ -86,6 +132,13 @@ func (q *QueriesMetrics) GetMetrics(ctx context.Context) []prometheus.Collector
 			queriesFailedGauge.With(prometheus.Labels{
 				"chain": query.Chain,
 			}).Inc()
+
+			if query.ErrorClass != constants.QueryErrorClassNone {
+				queriesErrorsByClassGauge.With(prometheus.Labels{
+					"chain": query.Chain,
+					"class": string(query.ErrorClass),
+				}).Inc()
+			}
 		}
 	}
 
@@ -94,5 +147,8 @@ func (q *QueriesMetrics) GetMetrics(ctx context.Context) []prometheus.Collector
 		queriesSuccessfulGauge,
 		queriesFailedGauge,
 		timingsGauge,
+		queriesErrorsByClassGauge,
+		retriesGauge,
+		queueWaitGauge,
 	}
 }
@@ -3,6 +3,7 @@ package pkg
 import (
 	"context"
 	"main/pkg/config"
+	"main/pkg/constants"
 	"main/pkg/types"
 	"testing"
 	"time"
@@ -17,8 +18,8 @@ func TestQuerierMetrics(t *testing.T) {
 
 	queryInfos := []*types.QueryInfo{
 		{Success: true, Chain: "chain", Duration: 2 * time.Second, URL: "url1"},
-		{Success: true, Chain: "chain", Duration: 4 * time.Second, URL: "url2"},
-		{Success: false, Chain: "chain", Duration: 6 * time.Second, URL: "url3"},
+		{Success: true, Chain: "chain", Duration: 4 * time.Second, URL: "url2", QueueWait: 500 * time.Millisecond},
+		{Success: false, Chain: "chain", Duration: 6 * time.Second, URL: "url3?height=100", Attempts: 3, ErrorClass: constants.QueryErrorClassServerError, QueueWait: time.Second},
 	}
 
 	chains := []*config.Chain{
@@ -28,7 +29,7 @@ func TestQuerierMetrics(t *testing.T) {
 
 	generator := NewQueriesMetrics(chains, queryInfos)
 	metrics := generator.GetMetrics(context.Background())
-	assert.Len(t, metrics, 4)
+	assert.Len(t, metrics, 7)
 
 	queriesCountGauge, ok := metrics[0].(*prometheus.GaugeVec)
 	assert.True(t, ok)
@@ -75,4 +76,32 @@ func TestQuerierMetrics(t *testing.T) {
 		"chain": "chain",
 		"url":   "url3",
 	})), 0.01)
+
+	errorsByClass, ok := metrics[4].(*prometheus.GaugeVec)
+	assert.True(t, ok)
+	assert.Equal(t, 1, testutil.CollectAndCount(errorsByClass))
+	assert.InDelta(t, 1, testutil.ToFloat64(errorsByClass.With(prometheus.Labels{
+		"chain": "chain",
+		"class": "server_error",
+	})), 0.01)
+
+	retries, ok := metrics[5].(*prometheus.GaugeVec)
+	assert.True(t, ok)
+	assert.Equal(t, 2, testutil.CollectAndCount(retries))
+	assert.InDelta(t, 2, testutil.ToFloat64(retries.With(prometheus.Labels{
+		"chain": "chain",
+	})), 0.01)
+	assert.Zero(t, testutil.ToFloat64(retries.With(prometheus.Labels{
+		"chain": "chain2",
+	})))
+
+	queueWait, ok := metrics[6].(*prometheus.GaugeVec)
+	assert.True(t, ok)
+	assert.Equal(t, 2, testutil.CollectAndCount(queueWait))
+	assert.InDelta(t, 1.5, testutil.ToFloat64(queueWait.With(prometheus.Labels{
+		"chain": "chain",
+	})), 0.01)
+	assert.Zero(t, testutil.ToFloat64(queueWait.With(prometheus.Labels{
+		"chain": "chain2",
+	})))
 }
@@ -0,0 +1,126 @@
+package ratelimit
+
+import (
+	"context"
+	"main/pkg/config"
+	"sync"
+	"time"
+)
+
+type Limiter struct {
+	config config.RateLimitConfig
+
+	mutex sync.Mutex
+	hosts map[string]*hostLimiter
+}
+
+type hostLimiter struct {
+	inFlight chan struct{}
+
+	mutex      sync.Mutex
+	tokens     float64
+	lastRefill time.Time
+}
+
+func NewLimiter(rateLimitConfig config.RateLimitConfig) *Limiter {
+	return &Limiter{
+		config: rateLimitConfig,
+		hosts:  map[string]*hostLimiter{},
+	}
+}
+
+func (l *Limiter) Enabled() bool {
+	return l.config.RequestsPerSecond > 0 || l.config.MaxInFlight > 0
+}
+
+func (l *Limiter) Acquire(ctx context.Context, host string) (func(), time.Duration, error) {
+	if !l.Enabled() {
+		return func() {}, 0, nil
+	}
+
+	start := time.Now()
+	limiter := l.getHostLimiter(host)
+
+	if limiter.inFlight != nil {
+		select {
+		case limiter.inFlight <- struct{}{}:
+		case <-ctx.Done():
+			return nil, time.Since(start), ctx.Err()
+		}
+	}
+
+	release := func() {
+		if limiter.inFlight != nil {
+			<-limiter.inFlight
+		}
+	}
+
+	wait := limiter.reserve(l.config)
+	if wait > 0 {
+		timer := time.NewTimer(wait)
+		defer timer.Stop()
+
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			limiter.cancelReservation()
+			release()
+			return nil, time.Since(start), ctx.Err()
+		}
+	}
+
+	return release, time.Since(start), nil
+}
+
+func (l *Limiter) getHostLimiter(host string) *hostLimiter {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	limiter, ok := l.hosts[host]
+	if !ok {
+		limiter = &hostLimiter{
+			tokens:     float64(l.config.GetBurst()),
+			lastRefill: time.Now(),
+		}
+
+		if l.config.MaxInFlight > 0 {
+			limiter.inFlight = make(chan struct{}, l.config.MaxInFlight)
+		}
+
+		l.hosts[host] = limiter
+	}
+
+	return limiter
+}
+
+// reserve can take the bucket below zero, so the waiting queries are sent in order.
+func (h *hostLimiter) reserve(rateLimitConfig config.RateLimitConfig) time.Duration {
+	if rateLimitConfig.RequestsPerSecond <= 0 {
+		return 0
+	}
+
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	now := time.Now()
+
+	h.tokens = min(
+		h.tokens+now.Sub(h.lastRefill).Seconds()*rateLimitConfig.RequestsPerSecond,
+		float64(rateLimitConfig.GetBurst()),
+	)
+	h.lastRefill = now
+	h.tokens--
+
+	if h.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-h.tokens / rateLimitConfig.RequestsPerSecond * float64(time.Second))
+}
+
+func (h *hostLimiter) cancelReservation() {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	h.tokens++
+}
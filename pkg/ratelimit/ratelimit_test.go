@@ -0,0 +1,110 @@
+package ratelimit
+
+import (
+	"context"
+	"main/pkg/config"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLimiterDisabled(t *testing.T) {
+	t.Parallel()
+
+	limiter := NewLimiter(config.RateLimitConfig{})
+	assert.False(t, limiter.Enabled())
+
+	for range 100 {
+		release, waited, err := limiter.Acquire(context.Background(), "host")
+		require.NoError(t, err)
+		assert.Zero(t, waited)
+		release()
+	}
+}
+
+func TestLimiterRequestsPerSecond(t *testing.T) {
+	t.Parallel()
+
+	limiter := NewLimiter(config.RateLimitConfig{RequestsPerSecond: 20, Burst: 2})
+
+	start := time.Now()
+
+	for range 4 {
+		release, _, err := limiter.Acquire(context.Background(), "host")
+		require.NoError(t, err)
+		release()
+	}
+
+	// 2 queries are sent at once, the other 2 wait for 50ms each
+	assert.GreaterOrEqual(t, time.Since(start), 90*time.Millisecond)
+
+	// other hosts have their own limits
+	_, waited, err := limiter.Acquire(context.Background(), "other")
+	require.NoError(t, err)
+	assert.Less(t, waited, 10*time.Millisecond)
+}
+
+func TestLimiterMaxInFlight(t *testing.T) {
+	t.Parallel()
+
+	limiter := NewLimiter(config.RateLimitConfig{MaxInFlight: 2})
+
+	var (
+		inFlight    atomic.Int32
+		maxInFlight atomic.Int32
+		wg          sync.WaitGroup
+	)
+
+	for range 10 {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			release, _, err := limiter.Acquire(context.Background(), "host")
+			assert.NoError(t, err)
+
+			current := inFlight.Add(1)
+			for {
+				previous := maxInFlight.Load()
+				if current <= previous || maxInFlight.CompareAndSwap(previous, current) {
+					break
+				}
+			}
+
+			time.Sleep(10 * time.Millisecond)
+			inFlight.Add(-1)
+			release()
+		}()
+	}
+
+	wg.Wait()
+
+	assert.Equal(t, int32(2), maxInFlight.Load())
+}
+
+func TestLimiterCanceled(t *testing.T) {
+	t.Parallel()
+
+	limiter := NewLimiter(config.RateLimitConfig{MaxInFlight: 1})
+
+	release, _, err := limiter.Acquire(context.Background(), "host")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	_, waited, err := limiter.Acquire(ctx, "host")
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.GreaterOrEqual(t, waited, 20*time.Millisecond)
+
+	release()
+
+	release, _, err = limiter.Acquire(context.Background(), "host")
+	require.NoError(t, err)
+	release()
+}
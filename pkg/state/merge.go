@@ -0,0 +1,96 @@
+package state
+
+import (
+	"reflect"
+)
+
+func MergeWithPrevious(current, previous any, failedChains map[string]bool) any {
+	if previous == nil {
+		return current
+	}
+
+	if current == nil {
+		return previous
+	}
+
+	currentValue := reflect.ValueOf(current)
+	previousValue := reflect.ValueOf(previous)
+
+	if currentValue.Type() != previousValue.Type() {
+		return current
+	}
+
+	switch currentValue.Kind() {
+	case reflect.Map:
+		mergeChains(currentValue, previousValue, failedChains)
+		return current
+	case reflect.Struct:
+		merged := reflect.New(currentValue.Type()).Elem()
+		merged.Set(currentValue)
+
+		for index := range merged.NumField() {
+			field := merged.Field(index)
+			if !field.CanSet() || field.Kind() != reflect.Map {
+				continue
+			}
+
+			if !field.IsNil() {
+				mergeChains(field, previousValue.Field(index), failedChains)
+				continue
+			}
+
+			fieldMap := reflect.MakeMap(field.Type())
+			mergeChains(fieldMap, previousValue.Field(index), failedChains)
+
+			if fieldMap.Len() > 0 {
+				field.Set(fieldMap)
+			}
+		}
+
+		return merged.Interface()
+	default:
+		return current
+	}
+}
+
+func mergeChains(current, previous reflect.Value, failedChains map[string]bool) {
+	if previous.IsNil() || current.Type().Key().Kind() != reflect.String {
+		return
+	}
+
+	iter := previous.MapRange()
+	for iter.Next() {
+		if !failedChains[iter.Key().String()] {
+			continue
+		}
+
+		currentElem := current.MapIndex(iter.Key())
+		if !currentElem.IsValid() {
+			current.SetMapIndex(iter.Key(), iter.Value())
+			continue
+		}
+
+		if currentElem.Kind() == reflect.Map && !currentElem.IsNil() {
+			mergeMaps(currentElem, iter.Value())
+		}
+	}
+}
+
+func mergeMaps(current, previous reflect.Value) {
+	if previous.IsNil() {
+		return
+	}
+
+	iter := previous.MapRange()
+	for iter.Next() {
+		currentElem := current.MapIndex(iter.Key())
+		if !currentElem.IsValid() {
+			current.SetMapIndex(iter.Key(), iter.Value())
+			continue
+		}
+
+		if currentElem.Kind() == reflect.Map && !currentElem.IsNil() {
+			mergeMaps(currentElem, iter.Value())
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package state
+
+import (
+	"main/pkg/types"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type DelegationsTestData struct {
+	Delegations map[string]map[string]uint64
+}
+
+type SupplyTestData struct {
+	Supplies map[string][]types.Amount
+}
+
+func TestMergeWithPreviousNoPrevious(t *testing.T) {
+	t.Parallel()
+
+	current := DelegationsTestData{}
+	require.Equal(t, current, MergeWithPrevious(current, nil, nil))
+}
+
+func TestMergeWithPreviousNoCurrent(t *testing.T) {
+	t.Parallel()
+
+	previous := DelegationsTestData{}
+	require.Equal(t, previous, MergeWithPrevious(nil, previous, nil))
+}
+
+func TestMergeWithPreviousDifferentTypes(t *testing.T) {
+	t.Parallel()
+
+	current := DelegationsTestData{}
+	require.Equal(t, current, MergeWithPrevious(current, "string", nil))
+}
+
+func TestMergeWithPreviousNotMergeable(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "current", MergeWithPrevious("current", "previous", nil))
+}
+
+func TestMergeWithPreviousMap(t *testing.T) {
+	t.Parallel()
+
+	current := map[string]uint64{"chain1": 1}
+	previous := map[string]uint64{"chain1": 2, "chain2": 3, "chain3": 4}
+
+	merged := MergeWithPrevious(current, previous, map[string]bool{"chain2": true})
+	require.Equal(t, map[string]uint64{"chain1": 1, "chain2": 3}, merged)
+}
+
+func TestMergeWithPreviousNested(t *testing.T) {
+	t.Parallel()
+
+	current := DelegationsTestData{
+		Delegations: map[string]map[string]uint64{
+			"chain1": {"validator1": 10},
+			"chain2": {},
+		},
+	}
+	previous := DelegationsTestData{
+		Delegations: map[string]map[string]uint64{
+			"chain1":  {"validator1": 5, "validator2": 6},
+			"chain2":  {"validator1": 7},
+			"chain3":  {"validator1": 8},
+			"removed": {"validator1": 9},
+		},
+	}
+
+	merged, ok := MergeWithPrevious(current, previous, map[string]bool{
+		"chain2": true,
+		"chain3": true,
+	}).(DelegationsTestData)
+	require.True(t, ok)
+	require.Equal(t, map[string]map[string]uint64{
+		"chain1": {"validator1": 10},
+		"chain2": {"validator1": 7},
+		"chain3": {"validator1": 8},
+	}, merged.Delegations)
+}
+
+func TestMergeWithPreviousNilField(t *testing.T) {
+	t.Parallel()
+
+	current := SupplyTestData{}
+	previous := SupplyTestData{
+		Supplies: map[string][]types.Amount{"chain": {{Amount: 1, Denom: "denom"}}},
+	}
+
+	merged, ok := MergeWithPrevious(current, previous, map[string]bool{"chain": true}).(SupplyTestData)
+	require.True(t, ok)
+	require.Equal(t, previous.Supplies, merged.Supplies)
+
+	merged, ok = MergeWithPrevious(current, previous, nil).(SupplyTestData)
+	require.True(t, ok)
+	require.Nil(t, merged.Supplies)
+}
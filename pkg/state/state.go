@@ -1,6 +1,7 @@
 package state
 
 import (
+	"encoding/json"
 	"fmt"
 	"main/pkg/constants"
 	"reflect"
@@ -31,7 +32,7 @@ func (s *State) GetData(fetcherNames []constants.FetcherName) []any {
 	return data
 }
 
-func (s *State) Set(fetcherName constants.FetcherName, data any) {
+func (s *State) set(fetcherName constants.FetcherName, data any) {
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
 
@@ -54,31 +55,52 @@ func (s *State) Length() int {
 	return len(s.data)
 }
 
-func StateGet[T any](state *State, fetcherName constants.FetcherName) (T, bool) {
-	var zero T
+type Key[T any] struct {
+	Name constants.FetcherName
+}
 
-	dataRaw, found := state.Get(fetcherName)
-	if !found {
-		return zero, false
+var (
+	decoders      = map[constants.FetcherName]func(raw []byte) (any, error){}
+	decodersMutex sync.RWMutex
+)
+
+func NewKey[T any](fetcherName constants.FetcherName) Key[T] {
+	decodersMutex.Lock()
+	defer decodersMutex.Unlock()
+
+	decoders[fetcherName] = func(raw []byte) (any, error) {
+		var data T
+		err := json.Unmarshal(raw, &data)
+		return data, err
 	}
 
-	return Convert[T](dataRaw)
+	return Key[T]{Name: fetcherName}
 }
 
-func Convert[T any](input any) (T, bool) {
+func Decode(fetcherName constants.FetcherName, raw []byte) (any, error) {
+	decodersMutex.RLock()
+	decoder, ok := decoders[fetcherName]
+	decodersMutex.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf("no state key registered for fetcher %s", fetcherName)
+	}
+
+	return decoder(raw)
+}
+
+func Get[T any](state *State, key Key[T]) (T, bool) {
 	var zero T
 
-	if input == nil {
+	dataRaw, found := state.Get(key.Name)
+	if !found || dataRaw == nil {
 		return zero, false
 	}
 
-	data, converted := input.(T)
-	if !converted {
-		panic(fmt.Sprintf(
-			"Error converting data: expected %s, got %s",
-			reflect.TypeOf(zero).String(),
-			reflect.TypeOf(input).String(),
-		))
+	// Data is only written with Set, so it always has the type of its key.
+	data, err := Convert[T](dataRaw)
+	if err != nil {
+		return zero, false
 	}
 
 	if reflect.ValueOf(data).Kind() == reflect.Ptr && reflect.ValueOf(data).IsNil() {
@@ -87,3 +109,26 @@ func Convert[T any](input any) (T, bool) {
 
 	return data, true
 }
+
+func Set[T any](state *State, key Key[T], data T) {
+	state.set(key.Name, data)
+}
+
+func Convert[T any](input any) (T, error) {
+	var zero T
+
+	if input == nil {
+		return zero, nil
+	}
+
+	data, converted := input.(T)
+	if !converted {
+		return zero, fmt.Errorf(
+			"error converting data: expected %s, got %s",
+			reflect.TypeOf((*T)(nil)).Elem().String(),
+			reflect.TypeOf(input).String(),
+		)
+	}
+
+	return data, nil
+}
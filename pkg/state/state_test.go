@@ -2,7 +2,7 @@ package state
 
 import (
 	"main/pkg/constants"
-	fetchersPkg "main/pkg/fetchers"
+	"main/pkg/types"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -20,37 +20,84 @@ func TestStateGetNoValue(t *testing.T) {
 func TestStateConvertNoValue(t *testing.T) {
 	t.Parallel()
 
-	value, found := Convert[fetchersPkg.CommissionData](nil)
-	require.False(t, found)
+	value, err := Convert[SupplyTestData](nil)
+	require.NoError(t, err)
 	require.Zero(t, value)
 }
 
 func TestStateConvertWrongType(t *testing.T) {
 	t.Parallel()
 
-	defer func() {
-		if r := recover(); r == nil {
-			require.Fail(t, "Expected to have a panic here!")
-		}
-	}()
+	value, err := Convert[int64]("string")
+	require.Error(t, err)
+	require.ErrorContains(t, err, "expected int64, got string")
+	require.Zero(t, value)
+}
+
+func TestStateConvertOk(t *testing.T) {
+	t.Parallel()
 
-	Convert[int64]("string")
+	value, err := Convert[string]("string")
+	require.NoError(t, err)
+	require.Equal(t, "string", value)
 }
 
-func TestStateConvertNilPointer(t *testing.T) {
+func TestStateTypedKeyNilPointer(t *testing.T) {
 	t.Parallel()
 
-	var data *fetchersPkg.CommissionData
+	key := Key[*SupplyTestData]{Name: constants.FetcherNameSupply}
+
+	state := NewState()
+	Set(state, key, nil)
 
-	value, found := Convert[*fetchersPkg.CommissionData](data)
+	value, found := Get(state, key)
+	require.False(t, found)
+	require.Nil(t, value)
+}
+
+func TestStateTypedKeyNoValue(t *testing.T) {
+	t.Parallel()
+
+	key := NewKey[SupplyTestData](constants.FetcherNameSupply)
+
+	state := NewState()
+	value, found := Get(state, key)
 	require.False(t, found)
 	require.Zero(t, value)
 }
 
-func TestStateConvertOk(t *testing.T) {
+func TestStateTypedKeyOk(t *testing.T) {
 	t.Parallel()
 
-	value, found := Convert[string]("string")
+	key := NewKey[SupplyTestData](constants.FetcherNameSupply)
+	data := SupplyTestData{Supplies: map[string][]types.Amount{"chain": {{Amount: 1, Denom: "denom"}}}}
+
+	state := NewState()
+	Set(state, key, data)
+
+	value, found := Get(state, key)
 	require.True(t, found)
-	require.Equal(t, "string", value)
+	require.Equal(t, data, value)
+
+	raw, found := state.Get(constants.FetcherNameSupply)
+	require.True(t, found)
+	require.Equal(t, data, raw)
+}
+
+func TestStateDecode(t *testing.T) {
+	t.Parallel()
+
+	NewKey[SupplyTestData](constants.FetcherNameSupply)
+
+	value, err := Decode(constants.FetcherNameSupply, []byte(`{"Supplies":{"chain":[{"Amount":1,"Denom":"denom"}]}}`))
+	require.NoError(t, err)
+	require.Equal(t, SupplyTestData{
+		Supplies: map[string][]types.Amount{"chain": {{Amount: 1, Denom: "denom"}}},
+	}, value)
+
+	_, err = Decode(constants.FetcherNameSupply, []byte("invalid"))
+	require.Error(t, err)
+
+	_, err = Decode(constants.FetcherNameStub1, []byte("{}"))
+	require.Error(t, err)
 }
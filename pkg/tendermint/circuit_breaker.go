@@ -0,0 +1,106 @@
+package tendermint
+
+import (
+	"main/pkg/config"
+	"sync"
+	"time"
+)
+
+type CircuitState int
+
+const (
+	CircuitClosed CircuitState = iota
+	CircuitHalfOpen
+	CircuitOpen
+)
+
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitHalfOpen:
+		return "half-open"
+	case CircuitOpen:
+		return "open"
+	default:
+		return "closed"
+	}
+}
+
+type CircuitBreaker struct {
+	failureThreshold int
+	coolDown         time.Duration
+
+	mutex               sync.Mutex
+	state               CircuitState
+	consecutiveFailures int
+	openedAt            time.Time
+	probeStartedAt      time.Time
+}
+
+func NewCircuitBreaker(breakerConfig config.CircuitBreakerConfig) *CircuitBreaker {
+	return &CircuitBreaker{
+		failureThreshold: breakerConfig.FailureThreshold,
+		coolDown:         time.Duration(breakerConfig.CoolDown) * time.Second,
+	}
+}
+
+func (b *CircuitBreaker) Allow() bool {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	switch b.state {
+	case CircuitOpen:
+		if time.Since(b.openedAt) < b.coolDown {
+			return false
+		}
+
+		b.state = CircuitHalfOpen
+		b.probeStartedAt = time.Now()
+
+		return true
+	case CircuitHalfOpen:
+		if time.Since(b.probeStartedAt) < b.coolDown {
+			return false
+		}
+
+		b.probeStartedAt = time.Now()
+
+		return true
+	default:
+		return true
+	}
+}
+
+func (b *CircuitBreaker) RecordSuccess() {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.state = CircuitClosed
+	b.consecutiveFailures = 0
+}
+
+func (b *CircuitBreaker) RecordFailure() bool {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.consecutiveFailures++
+
+	if b.failureThreshold <= 0 || b.state == CircuitOpen {
+		return false
+	}
+
+	if b.state == CircuitHalfOpen || b.consecutiveFailures >= b.failureThreshold {
+		b.state = CircuitOpen
+		b.openedAt = time.Now()
+
+		return true
+	}
+
+	return false
+}
+
+func (b *CircuitBreaker) State() CircuitState {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	return b.state
+}
@@ -0,0 +1,65 @@
+package tendermint
+
+import (
+	"main/pkg/config"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCircuitBreakerDisabled(t *testing.T) {
+	t.Parallel()
+
+	breaker := NewCircuitBreaker(config.CircuitBreakerConfig{})
+
+	for range 100 {
+		assert.False(t, breaker.RecordFailure())
+	}
+
+	assert.True(t, breaker.Allow())
+	assert.Equal(t, CircuitClosed, breaker.State())
+}
+
+func TestCircuitBreakerOpens(t *testing.T) {
+	t.Parallel()
+
+	breaker := NewCircuitBreaker(config.CircuitBreakerConfig{FailureThreshold: 3, CoolDown: 60})
+
+	assert.False(t, breaker.RecordFailure())
+	assert.False(t, breaker.RecordFailure())
+	breaker.RecordSuccess()
+	assert.False(t, breaker.RecordFailure())
+	assert.False(t, breaker.RecordFailure())
+	assert.True(t, breaker.Allow())
+
+	assert.True(t, breaker.RecordFailure())
+	assert.Equal(t, CircuitOpen, breaker.State())
+	assert.False(t, breaker.Allow())
+}
+
+func TestCircuitBreakerHalfOpen(t *testing.T) {
+	t.Parallel()
+
+	breaker := NewCircuitBreaker(config.CircuitBreakerConfig{FailureThreshold: 1, CoolDown: 60})
+	assert.True(t, breaker.RecordFailure())
+
+	// pretending the cool-down has passed
+	breaker.openedAt = time.Now().Add(-time.Minute)
+
+	assert.True(t, breaker.Allow())
+	assert.Equal(t, CircuitHalfOpen, breaker.State())
+	assert.False(t, breaker.Allow(), "only a single probe should be allowed")
+
+	assert.True(t, breaker.RecordFailure())
+	assert.Equal(t, CircuitOpen, breaker.State())
+	assert.False(t, breaker.Allow())
+
+	breaker.openedAt = time.Now().Add(-time.Minute)
+
+	assert.True(t, breaker.Allow())
+	breaker.RecordSuccess()
+	assert.Equal(t, CircuitClosed, breaker.State())
+	assert.True(t, breaker.Allow())
+	assert.True(t, breaker.Allow())
+}
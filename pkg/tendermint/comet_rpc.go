@@ -0,0 +1,399 @@
+package tendermint
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"main/pkg/config"
+	"main/pkg/constants"
+	"main/pkg/http"
+	"main/pkg/types"
+	"main/pkg/utils"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/rs/zerolog"
+)
+
+type CometRPC struct {
+	ChainName string
+	Host      string
+	Client    *http.Client
+	Logger    zerolog.Logger
+	Tracer    trace.Tracer
+	// separate from the chain's LCD and gRPC breaker
+	Breaker *CircuitBreaker
+	// only the canonical ones are cached
+	Commits    *HeightCache[types.CometCommit]
+	BlockMetas *HeightCache[types.CometBlockMeta]
+
+	LastHeight int64
+	Mutex      sync.Mutex
+}
+
+func NewCometRPC(
+	chain config.ChainInfo,
+	timeout int,
+	logger zerolog.Logger,
+	tracer trace.Tracer,
+) *CometRPC {
+	if chain.GetRPCEndpoint() == "" {
+		return nil
+	}
+
+	return &CometRPC{
+		ChainName: chain.GetName(),
+		Host:      chain.GetRPCEndpoint(),
+		Client: http.NewClient(
+			&logger,
+			chain.GetName(),
+			time.Duration(timeout)*time.Second,
+			chain.GetRetries(),
+			chain.GetTransport(),
+			chain.GetRateLimit(),
+			tracer,
+		),
+		Logger: logger.With().
+			Str("component", "comet_rpc").
+			Str("chain", chain.GetName()).
+			Logger(),
+		Tracer:     tracer,
+		Breaker:    NewCircuitBreaker(chain.GetCircuitBreaker()),
+		Commits:    NewHeightCache[types.CometCommit](),
+		BlockMetas: NewHeightCache[types.CometBlockMeta](),
+	}
+}
+
+func (rpc *CometRPC) GetStatus(ctx context.Context) (*types.CometStatus, *types.QueryInfo, error) {
+	childQuerierCtx, span := rpc.Tracer.Start(ctx, "Fetching CometBFT status")
+	defer span.End()
+
+	status, info, err := cometGet[types.CometStatus](childQuerierCtx, rpc, "/status")
+	if err != nil {
+		return nil, &info, err
+	}
+
+	err = rpc.checkLatestHeight(status.SyncInfo.LatestBlockHeight)
+	if err != nil {
+		return nil, rpc.failedInfo(info), err
+	}
+
+	return status, &info, nil
+}
+
+func (rpc *CometRPC) GetNetInfo(ctx context.Context) (*types.CometNetInfo, *types.QueryInfo, error) {
+	childQuerierCtx, span := rpc.Tracer.Start(ctx, "Fetching CometBFT net info")
+	defer span.End()
+
+	netInfo, info, err := cometGet[types.CometNetInfo](childQuerierCtx, rpc, "/net_info")
+	if err != nil {
+		return nil, &info, err
+	}
+
+	return netInfo, &info, nil
+}
+
+func (rpc *CometRPC) GetBlock(
+	ctx context.Context,
+	height int64,
+) (*types.CometBlockResponse, *types.QueryInfo, error) {
+	childQuerierCtx, span := rpc.Tracer.Start(
+		ctx,
+		"Fetching CometBFT block",
+		trace.WithAttributes(attribute.Int64("height", height)),
+	)
+	defer span.End()
+
+	block, info, err := cometGet[types.CometBlockResponse](childQuerierCtx, rpc, withHeight("/block", height))
+	if err != nil {
+		return nil, &info, err
+	}
+
+	err = rpc.checkHeight(height, block.Block.Header.Height)
+	if err != nil {
+		return nil, rpc.failedInfo(info), err
+	}
+
+	return block, &info, nil
+}
+
+func (rpc *CometRPC) GetCommit(
+	ctx context.Context,
+	height int64,
+) (*types.CometCommitResponse, *types.QueryInfo, error) {
+	childQuerierCtx, span := rpc.Tracer.Start(
+		ctx,
+		"Fetching CometBFT commit",
+		trace.WithAttributes(attribute.Int64("height", height)),
+	)
+	defer span.End()
+
+	commit, info, err := cometGet[types.CometCommitResponse](childQuerierCtx, rpc, withHeight("/commit", height))
+	if err != nil {
+		return nil, &info, err
+	}
+
+	err = rpc.checkHeight(height, commit.SignedHeader.Header.Height)
+	if err != nil {
+		return nil, rpc.failedInfo(info), err
+	}
+
+	return commit, &info, nil
+}
+
+func (rpc *CometRPC) GetBlockchain(
+	ctx context.Context,
+	minHeight int64,
+	maxHeight int64,
+) (*types.CometBlockchainResponse, *types.QueryInfo, error) {
+	childQuerierCtx, span := rpc.Tracer.Start(
+		ctx,
+		"Fetching CometBFT blockchain",
+		trace.WithAttributes(attribute.Int64("min-height", minHeight), attribute.Int64("max-height", maxHeight)),
+	)
+	defer span.End()
+
+	query := url.Values{}
+
+	if minHeight > 0 {
+		query.Set("minHeight", strconv.FormatInt(minHeight, 10))
+	}
+
+	if maxHeight > 0 {
+		query.Set("maxHeight", strconv.FormatInt(maxHeight, 10))
+	}
+
+	path := "/blockchain"
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+
+	blockchain, info, err := cometGet[types.CometBlockchainResponse](childQuerierCtx, rpc, path)
+	if err != nil {
+		return nil, &info, err
+	}
+
+	if maxHeight == 0 {
+		err = rpc.checkLatestHeight(blockchain.LastHeight)
+		if err != nil {
+			return nil, rpc.failedInfo(info), err
+		}
+
+		return blockchain, &info, nil
+	}
+
+	for _, meta := range blockchain.BlockMetas {
+		if meta.Header.Height < minHeight || meta.Header.Height > maxHeight {
+			return nil, rpc.failedInfo(info), fmt.Errorf(
+				"requested heights from %d to %d, but got %d",
+				minHeight,
+				maxHeight,
+				meta.Header.Height,
+			)
+		}
+	}
+
+	return blockchain, &info, nil
+}
+
+func (rpc *CometRPC) GetValidators(
+	ctx context.Context,
+	height int64,
+	page int,
+	perPage int,
+) (*types.CometValidatorsResponse, *types.QueryInfo, error) {
+	childQuerierCtx, span := rpc.Tracer.Start(
+		ctx,
+		"Fetching CometBFT validators",
+		trace.WithAttributes(attribute.Int64("height", height), attribute.Int("page", page)),
+	)
+	defer span.End()
+
+	query := url.Values{}
+	query.Set("page", strconv.Itoa(page))
+	query.Set("per_page", strconv.Itoa(perPage))
+
+	if height > 0 {
+		query.Set("height", strconv.FormatInt(height, 10))
+	}
+
+	validators, info, err := cometGet[types.CometValidatorsResponse](
+		childQuerierCtx,
+		rpc,
+		"/validators?"+query.Encode(),
+	)
+	if err != nil {
+		return nil, &info, err
+	}
+
+	err = rpc.checkHeight(height, validators.BlockHeight)
+	if err != nil {
+		return nil, rpc.failedInfo(info), err
+	}
+
+	return validators, &info, nil
+}
+
+func (rpc *CometRPC) GetValidatorSet(
+	ctx context.Context,
+	height int64,
+) ([]types.CometValidator, *types.QueryInfo, error) {
+	validators := []types.CometValidator{}
+
+	var info types.QueryInfo
+
+	for page := 1; ; page++ {
+		response, pageInfo, err := rpc.GetValidators(ctx, height, page, constants.CometValidatorsPerPage)
+
+		if page == 1 {
+			info = *pageInfo
+			info.Pages = 1
+		} else {
+			info.Pages++
+			info.Duration += pageInfo.Duration
+			info.Attempts += pageInfo.Attempts
+			info.QueueWait += pageInfo.QueueWait
+			info.StatusCode = pageInfo.StatusCode
+			info.ErrorClass = pageInfo.ErrorClass
+			info.Success = pageInfo.Success
+		}
+
+		if err != nil {
+			return nil, &info, err
+		}
+
+		validators = append(validators, response.Validators...)
+
+		if len(response.Validators) == 0 || len(validators) >= response.Total {
+			return validators, &info, nil
+		}
+
+		// Next pages should be for the same height as the first one.
+		height = response.BlockHeight
+	}
+}
+
+func (rpc *CometRPC) GetConsensusState(
+	ctx context.Context,
+) (*types.CometConsensusStateResponse, *types.QueryInfo, error) {
+	childQuerierCtx, span := rpc.Tracer.Start(ctx, "Fetching CometBFT consensus state")
+	defer span.End()
+
+	state, info, err := cometGet[types.CometConsensusStateResponse](childQuerierCtx, rpc, "/dump_consensus_state")
+	if err != nil {
+		return nil, &info, err
+	}
+
+	return state, &info, nil
+}
+
+func (rpc *CometRPC) checkHeight(requested, returned int64) error {
+	if requested == 0 {
+		return rpc.checkLatestHeight(returned)
+	}
+
+	if requested != returned {
+		return fmt.Errorf("requested height %d, but got %d", requested, returned)
+	}
+
+	return nil
+}
+
+func (rpc *CometRPC) checkLatestHeight(height int64) error {
+	rpc.Mutex.Lock()
+	defer rpc.Mutex.Unlock()
+
+	if height < rpc.LastHeight {
+		return fmt.Errorf(
+			"previous height (%d) is bigger than the current height (%d)",
+			rpc.LastHeight,
+			height,
+		)
+	}
+
+	rpc.LastHeight = height
+
+	return nil
+}
+
+func (rpc *CometRPC) failedInfo(info types.QueryInfo) *types.QueryInfo {
+	info.Success = false
+	info.ErrorClass = constants.QueryErrorClassStaleHeight
+	return &info
+}
+
+// forEachParallel runs process for each index, with at most CometMaxParallelQueries running at once.
+func forEachParallel(count int, process func(index int)) {
+	var wg sync.WaitGroup
+
+	indexes := make(chan int)
+
+	for range min(count, constants.CometMaxParallelQueries) {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for index := range indexes {
+				process(index)
+			}
+		}()
+	}
+
+	for index := range count {
+		indexes <- index
+	}
+
+	close(indexes)
+	wg.Wait()
+}
+
+func withHeight(path string, height int64) string {
+	if height == 0 {
+		return path
+	}
+
+	return fmt.Sprintf("%s?height=%d", path, height)
+}
+
+func cometGet[T any](
+	ctx context.Context,
+	rpc *CometRPC,
+	path string,
+) (*T, types.QueryInfo, error) {
+	if !rpc.Breaker.Allow() {
+		return nil, types.QueryInfo{
+			Chain:      rpc.ChainName,
+			URL:        utils.RedactURL(rpc.Host + path),
+			ErrorClass: constants.QueryErrorClassCircuitOpen,
+		}, errors.New("queries to the CometBFT RPC are skipped due to consecutive failures")
+	}
+
+	var response types.CometRPCResponse[T]
+
+	info, _, err := rpc.Client.Get(rpc.Host+path, &response, types.HTTPPredicateAlwaysPass(), ctx)
+
+	switch {
+	case err == nil, info.ErrorClass == constants.QueryErrorClassResponseCode:
+		// the node is up even if the query returned an error
+		rpc.Breaker.RecordSuccess()
+	case ctx.Err() != nil:
+	default:
+		if rpc.Breaker.RecordFailure() {
+			rpc.Logger.Warn().
+				Err(err).
+				Msg("Too many consecutive failed queries, skipping the queries to the CometBFT RPC for a while")
+		}
+	}
+
+	if err != nil {
+		return nil, info, err
+	}
+
+	return &response.Result, info, nil
+}
@@ -0,0 +1,191 @@
+package tendermint
+
+import (
+	"main/pkg/config"
+	"main/pkg/utils"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	endpointStatsWeight  = 0.2
+	endpointMaxHeightLag = 5
+)
+
+type Endpoint struct {
+	Host    string
+	MaxLag  time.Duration
+	Breaker *CircuitBreaker
+
+	mutex             sync.Mutex
+	latency           time.Duration
+	errorRate         float64
+	height            int64
+	queries           int64
+	errors            int64
+	heightRegressions int64
+	statusChecked     bool
+	lag               time.Duration
+	syncing           bool
+}
+
+type EndpointStats struct {
+	Host              string
+	Latency           time.Duration
+	ErrorRate         float64
+	Height            int64
+	Queries           int64
+	Errors            int64
+	HeightRegressions int64
+	StatusChecked     bool
+	Lag               time.Duration
+	Syncing           bool
+	CircuitState      CircuitState
+	Healthy           bool
+}
+
+func (e *Endpoint) RecordSuccess(latency time.Duration, height int64) {
+	e.Breaker.RecordSuccess()
+
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	e.queries++
+	e.errorRate *= 1 - endpointStatsWeight
+
+	if e.latency == 0 {
+		e.latency = latency
+	} else {
+		e.latency = time.Duration(
+			float64(e.latency)*(1-endpointStatsWeight) + float64(latency)*endpointStatsWeight,
+		)
+	}
+
+	if height > 0 {
+		e.height = height
+	}
+}
+
+func (e *Endpoint) RecordFailure() bool {
+	e.mutex.Lock()
+	e.queries++
+	e.errors++
+	e.errorRate = e.errorRate*(1-endpointStatsWeight) + endpointStatsWeight
+	e.mutex.Unlock()
+
+	return e.Breaker.RecordFailure()
+}
+
+func (e *Endpoint) RecordHeightRegression() {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	e.heightRegressions++
+}
+
+func (e *Endpoint) RecordStatus(latestBlockTime time.Time, syncing bool) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	e.statusChecked = true
+	e.lag = max(time.Since(latestBlockTime), 0)
+	e.syncing = syncing
+}
+
+func (e *Endpoint) isStale() bool {
+	if !e.statusChecked {
+		return false
+	}
+
+	return e.syncing || (e.MaxLag > 0 && e.lag > e.MaxLag)
+}
+
+func (e *Endpoint) Stats() EndpointStats {
+	circuitState := e.Breaker.State()
+
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	return EndpointStats{
+		Host:              utils.RedactURL(e.Host),
+		Latency:           e.latency,
+		ErrorRate:         e.errorRate,
+		Height:            e.height,
+		Queries:           e.queries,
+		Errors:            e.errors,
+		HeightRegressions: e.heightRegressions,
+		StatusChecked:     e.statusChecked,
+		Lag:               e.lag,
+		Syncing:           e.syncing,
+		CircuitState:      circuitState,
+		Healthy:           !e.isStale() && circuitState == CircuitClosed,
+	}
+}
+
+type Endpoints []*Endpoint
+
+func NewEndpoints(
+	hosts []string,
+	maxLag time.Duration,
+	breakerConfig config.CircuitBreakerConfig,
+) Endpoints {
+	endpoints := make(Endpoints, len(hosts))
+
+	for index, host := range hosts {
+		endpoints[index] = &Endpoint{
+			Host:    host,
+			MaxLag:  maxLag,
+			Breaker: NewCircuitBreaker(breakerConfig),
+		}
+	}
+
+	return endpoints
+}
+
+func (e Endpoints) Stats() []EndpointStats {
+	stats := make([]EndpointStats, len(e))
+	var maxHeight int64
+
+	for index, endpoint := range e {
+		stats[index] = endpoint.Stats()
+		maxHeight = max(maxHeight, stats[index].Height)
+	}
+
+	for index := range stats {
+		lagging := stats[index].Height > 0 && maxHeight-stats[index].Height > endpointMaxHeightLag
+		stats[index].Healthy = stats[index].Healthy && !lagging && stats[index].ErrorRate < 0.5
+	}
+
+	return stats
+}
+
+func (e Endpoints) Ordered() Endpoints {
+	stats := e.Stats()
+	indexes := make([]int, len(e))
+
+	for index := range indexes {
+		indexes[index] = index
+	}
+
+	sort.SliceStable(indexes, func(i, j int) bool {
+		first, second := stats[indexes[i]], stats[indexes[j]]
+
+		if first.Healthy != second.Healthy {
+			return first.Healthy
+		}
+
+		if first.ErrorRate != second.ErrorRate {
+			return first.ErrorRate < second.ErrorRate
+		}
+
+		return first.Latency < second.Latency
+	})
+
+	ordered := make(Endpoints, len(e))
+	for index, endpointIndex := range indexes {
+		ordered[index] = e[endpointIndex]
+	}
+
+	return ordered
+}
@@ -0,0 +1,138 @@
+package tendermint
+
+import (
+	"context"
+	"fmt"
+	"main/pkg/types"
+	"main/pkg/utils"
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func (rpc *RPC) CheckEndpoints(ctx context.Context) []*types.QueryInfo {
+	if rpc.MaxNodeLag <= 0 {
+		return []*types.QueryInfo{}
+	}
+
+	queryInfos := []*types.QueryInfo{}
+
+	var (
+		mutex sync.Mutex
+		wg    sync.WaitGroup
+	)
+
+	for _, endpoint := range rpc.Endpoints {
+		wg.Add(1)
+
+		go func(endpoint *Endpoint) {
+			defer wg.Done()
+
+			endpointQueryInfos := rpc.checkEndpoint(ctx, endpoint)
+
+			mutex.Lock()
+			queryInfos = append(queryInfos, endpointQueryInfos...)
+			mutex.Unlock()
+		}(endpoint)
+	}
+
+	wg.Wait()
+
+	return queryInfos
+}
+
+func (rpc *RPC) checkEndpoint(ctx context.Context, endpoint *Endpoint) []*types.QueryInfo {
+	childQuerierCtx, span := rpc.Tracer.Start(
+		ctx,
+		"Checking LCD endpoint status",
+		trace.WithAttributes(attribute.String("endpoint", utils.RedactURL(endpoint.Host))),
+	)
+	defer span.End()
+
+	var latestBlock types.LatestBlockResponse
+
+	latestBlockInfo, header, err := rpc.Client.Get(
+		endpoint.Host+"/cosmos/base/tendermint/v1beta1/blocks/latest",
+		&latestBlock,
+		types.HTTPPredicateAlwaysPass(),
+		childQuerierCtx,
+	)
+	if err == nil && latestBlock.Code != 0 {
+		latestBlockInfo.Success = false
+		err = fmt.Errorf("expected code 0, but got %d", latestBlock.Code)
+	}
+
+	if err != nil {
+		endpoint.RecordFailure()
+		rpc.logStatusCheckFailure(err, latestBlockInfo.URL)
+		return []*types.QueryInfo{&latestBlockInfo}
+	}
+
+	height, _ := utils.GetBlockHeightFromHeader(header)
+	endpoint.RecordSuccess(latestBlockInfo.Duration, max(height, latestBlock.Block.Header.Height))
+
+	var syncing types.SyncingResponse
+
+	syncingInfo, _, err := rpc.Client.Get(
+		endpoint.Host+"/cosmos/base/tendermint/v1beta1/syncing",
+		&syncing,
+		types.HTTPPredicateAlwaysPass(),
+		childQuerierCtx,
+	)
+	if err == nil && syncing.Code != 0 {
+		syncingInfo.Success = false
+		err = fmt.Errorf("expected code 0, but got %d", syncing.Code)
+	}
+
+	if err != nil {
+		endpoint.RecordFailure()
+		rpc.logStatusCheckFailure(err, syncingInfo.URL)
+		return []*types.QueryInfo{&latestBlockInfo, &syncingInfo}
+	}
+
+	endpoint.RecordStatus(latestBlock.Block.Header.Time, syncing.Syncing)
+
+	span.SetAttributes(attribute.Bool("syncing", syncing.Syncing))
+
+	return []*types.QueryInfo{&latestBlockInfo, &syncingInfo}
+}
+
+func (rpc *RPC) logStatusCheckFailure(err error, url string) {
+	rpc.Logger.Warn().
+		Err(err).
+		Str("url", url).
+		Msg("Could not check LCD endpoint status")
+}
+
+func CheckAllEndpoints(
+	ctx context.Context,
+	rpcs map[string]*RPCWithConsumers,
+) []*types.QueryInfo {
+	queryInfos := []*types.QueryInfo{}
+
+	var (
+		mutex sync.Mutex
+		wg    sync.WaitGroup
+	)
+
+	for _, rpcWithConsumers := range rpcs {
+		for _, rpc := range append([]*RPC{rpcWithConsumers.RPC}, rpcWithConsumers.Consumers...) {
+			wg.Add(1)
+
+			go func(rpc *RPC) {
+				defer wg.Done()
+
+				rpcQueryInfos := rpc.CheckEndpoints(ctx)
+
+				mutex.Lock()
+				queryInfos = append(queryInfos, rpcQueryInfos...)
+				mutex.Unlock()
+			}(rpc)
+		}
+	}
+
+	wg.Wait()
+
+	return queryInfos
+}
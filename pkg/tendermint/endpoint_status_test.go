@@ -0,0 +1,103 @@
+package tendermint
+
+import (
+	"context"
+	"fmt"
+	"main/assets"
+	"main/pkg/config"
+	"main/pkg/logger"
+	"main/pkg/tracing"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckEndpointsMaxNodeLagNotSet(t *testing.T) {
+	t.Parallel()
+
+	chain := &config.Chain{Name: "chain", LCDEndpoint: "https://example.com"}
+	rpc := NewRPC(chain, 10, *logger.GetNopLogger(), tracing.InitNoopTracer())
+
+	assert.Empty(t, rpc.CheckEndpoints(context.Background()))
+	assert.False(t, rpc.Endpoints.Stats()[0].StatusChecked)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestCheckEndpoints(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	freshBlock := fmt.Sprintf(
+		`{"block":{"header":{"height":"22000010","time":"%s"}}}`,
+		time.Now().UTC().Format(time.RFC3339Nano),
+	)
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://behind.example/cosmos/base/tendermint/v1beta1/blocks/latest",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("latest-block.json")),
+	)
+	httpmock.RegisterResponder(
+		"GET",
+		"https://behind.example/cosmos/base/tendermint/v1beta1/syncing",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("node-synced.json")),
+	)
+	httpmock.RegisterResponder(
+		"GET",
+		"https://catching-up.example/cosmos/base/tendermint/v1beta1/blocks/latest",
+		httpmock.NewStringResponder(200, freshBlock),
+	)
+	httpmock.RegisterResponder(
+		"GET",
+		"https://catching-up.example/cosmos/base/tendermint/v1beta1/syncing",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("node-syncing.json")),
+	)
+	httpmock.RegisterResponder(
+		"GET",
+		"https://synced.example/cosmos/base/tendermint/v1beta1/blocks/latest",
+		httpmock.NewStringResponder(200, freshBlock),
+	)
+	httpmock.RegisterResponder(
+		"GET",
+		"https://synced.example/cosmos/base/tendermint/v1beta1/syncing",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("node-synced.json")),
+	)
+
+	chain := &config.Chain{
+		Name:         "chain",
+		LCDEndpoint:  "https://behind.example",
+		LCDEndpoints: []string{"https://catching-up.example", "https://synced.example"},
+		MaxNodeLag:   60,
+	}
+
+	rpcs := map[string]*RPCWithConsumers{
+		"chain": RPCWithConsumersFromChain(chain, 10, *logger.GetNopLogger(), tracing.InitNoopTracer()),
+	}
+
+	queries := CheckAllEndpoints(context.Background(), rpcs)
+	assert.Len(t, queries, 6)
+
+	for _, query := range queries {
+		assert.True(t, query.Success)
+	}
+
+	endpoints := rpcs["chain"].RPC.Endpoints
+	stats := endpoints.Stats()
+	require.Len(t, stats, 3)
+
+	assert.True(t, stats[0].StatusChecked)
+	assert.False(t, stats[0].Syncing)
+	assert.Greater(t, stats[0].Lag, time.Minute)
+	assert.False(t, stats[0].Healthy)
+
+	assert.True(t, stats[1].Syncing)
+	assert.False(t, stats[1].Healthy)
+
+	assert.True(t, stats[2].Healthy)
+	assert.Equal(t, int64(22000010), stats[2].Height)
+
+	assert.Equal(t, "https://synced.example", endpoints.Ordered()[0].Host)
+}
@@ -0,0 +1,93 @@
+package tendermint
+
+import (
+	"main/pkg/config"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEndpointsOrderedPreferFaster(t *testing.T) {
+	t.Parallel()
+
+	endpoints := NewEndpoints([]string{"slow", "fast"}, 0, config.CircuitBreakerConfig{})
+	endpoints[0].RecordSuccess(time.Second, 100)
+	endpoints[1].RecordSuccess(100*time.Millisecond, 100)
+
+	ordered := endpoints.Ordered()
+	assert.Equal(t, "fast", ordered[0].Host)
+	assert.Equal(t, "slow", ordered[1].Host)
+}
+
+func TestEndpointsOrderedPreferNotFailing(t *testing.T) {
+	t.Parallel()
+
+	endpoints := NewEndpoints([]string{"failing", "working"}, 0, config.CircuitBreakerConfig{})
+	endpoints[0].RecordFailure()
+	endpoints[1].RecordSuccess(time.Second, 100)
+
+	ordered := endpoints.Ordered()
+	assert.Equal(t, "working", ordered[0].Host)
+
+	stats := endpoints.Stats()
+	assert.InDelta(t, endpointStatsWeight, stats[0].ErrorRate, 0.001)
+	assert.Equal(t, int64(1), stats[0].Errors)
+	assert.Equal(t, int64(1), stats[0].Queries)
+}
+
+func TestEndpointsOrderedPreferNotLagging(t *testing.T) {
+	t.Parallel()
+
+	endpoints := NewEndpoints([]string{"lagging", "synced"}, 0, config.CircuitBreakerConfig{})
+	endpoints[0].RecordSuccess(100*time.Millisecond, 100)
+	endpoints[1].RecordSuccess(time.Second, 200)
+
+	stats := endpoints.Stats()
+	assert.False(t, stats[0].Healthy)
+	assert.True(t, stats[1].Healthy)
+
+	ordered := endpoints.Ordered()
+	assert.Equal(t, "synced", ordered[0].Host)
+}
+
+func TestEndpointsErrorRateRecovers(t *testing.T) {
+	t.Parallel()
+
+	endpoints := NewEndpoints([]string{"endpoint"}, 0, config.CircuitBreakerConfig{})
+	for range 5 {
+		endpoints[0].RecordFailure()
+	}
+
+	assert.False(t, endpoints.Stats()[0].Healthy)
+
+	for range 10 {
+		endpoints[0].RecordSuccess(time.Second, 0)
+	}
+
+	assert.True(t, endpoints.Stats()[0].Healthy)
+	assert.Equal(t, time.Second, endpoints.Stats()[0].Latency)
+}
+
+func TestEndpointsOrderedPreferNotStale(t *testing.T) {
+	t.Parallel()
+
+	endpoints := NewEndpoints([]string{"catching-up", "behind", "synced", "not-checked"}, time.Minute, config.CircuitBreakerConfig{})
+	endpoints[0].RecordStatus(time.Now(), true)
+	endpoints[1].RecordStatus(time.Now().Add(-time.Hour), false)
+	endpoints[2].RecordStatus(time.Now(), false)
+
+	stats := endpoints.Stats()
+	assert.False(t, stats[0].Healthy)
+	assert.True(t, stats[0].Syncing)
+	assert.False(t, stats[1].Healthy)
+	assert.InDelta(t, time.Hour.Seconds(), stats[1].Lag.Seconds(), 1)
+	assert.True(t, stats[2].Healthy)
+	assert.True(t, stats[2].StatusChecked)
+	assert.True(t, stats[3].Healthy)
+	assert.False(t, stats[3].StatusChecked)
+
+	ordered := endpoints.Ordered()
+	assert.Equal(t, "synced", ordered[0].Host)
+	assert.Equal(t, "not-checked", ordered[1].Host)
+}
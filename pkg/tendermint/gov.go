@@ -0,0 +1,176 @@
+package tendermint
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"main/pkg/constants"
+	"main/pkg/http"
+	"main/pkg/types"
+	"main/pkg/utils"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	grpcCodes "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (rpc *RPC) GetActiveProposals(
+	ctx context.Context,
+	version constants.GovVersion,
+) ([]types.GovProposal, *types.QueryInfo, error) {
+	if !rpc.ChainQueries.Enabled("proposals") {
+		return nil, nil, nil
+	}
+
+	childQuerierCtx, span := rpc.Tracer.Start(
+		ctx,
+		"Fetching active proposals",
+		trace.WithAttributes(attribute.String("version", string(version))),
+	)
+	defer span.End()
+
+	path := fmt.Sprintf(
+		"/cosmos/gov/%s/proposals?proposal_status=%s",
+		version,
+		constants.GovProposalStatusVotingPeriod,
+	)
+
+	grpcQuery := GRPCQuery{
+		Method: fmt.Sprintf("cosmos.gov.%s.Query/Proposals", version),
+		Request: map[string]any{
+			"proposal_status": constants.GovProposalStatusVotingPeriod,
+		},
+	}
+
+	pages, info, err := queryAllPages[types.GovProposalsResponse](childQuerierCtx, rpc, path, grpcQuery)
+	if err != nil {
+		return nil, &info, err
+	}
+
+	proposals := []types.GovProposal{}
+	for _, page := range pages {
+		proposals = append(proposals, utils.Map(page.Proposals, func(p types.GovProposalResponse) types.GovProposal {
+			return p.ToProposal()
+		})...)
+	}
+
+	return proposals, &info, nil
+}
+
+func (rpc *RPC) GetProposalTally(
+	ctx context.Context,
+	version constants.GovVersion,
+	proposalID string,
+) (*types.GovTally, *types.QueryInfo, error) {
+	if !rpc.ChainQueries.Enabled("proposals") {
+		return nil, nil, nil
+	}
+
+	childQuerierCtx, span := rpc.Tracer.Start(
+		ctx,
+		"Fetching proposal tally",
+		trace.WithAttributes(attribute.String("proposal", proposalID)),
+	)
+	defer span.End()
+
+	path := fmt.Sprintf("/cosmos/gov/%s/proposals/%s/tally", version, proposalID)
+
+	grpcQuery := GRPCQuery{
+		Method: fmt.Sprintf("cosmos.gov.%s.Query/TallyResult", version),
+		Request: map[string]any{
+			"proposal_id": proposalID,
+		},
+	}
+
+	var response *types.GovTallyResponse
+
+	info, err := rpc.Query(path, grpcQuery, &response, childQuerierCtx)
+	if err != nil {
+		return nil, &info, err
+	}
+
+	if response.Code != 0 {
+		info.Success = false
+		return nil, &info, fmt.Errorf("expected code 0, but got %d", response.Code)
+	}
+
+	tally := response.Tally.ToTally()
+
+	return &tally, &info, nil
+}
+
+func (rpc *RPC) GetProposalVote(
+	ctx context.Context,
+	version constants.GovVersion,
+	proposalID string,
+	voter string,
+) (*types.GovVote, *types.QueryInfo, error) {
+	if !rpc.ChainQueries.Enabled("proposal-votes") {
+		return nil, nil, nil
+	}
+
+	childQuerierCtx, span := rpc.Tracer.Start(
+		ctx,
+		"Fetching proposal vote",
+		trace.WithAttributes(
+			attribute.String("proposal", proposalID),
+			attribute.String("voter", voter),
+		),
+	)
+	defer span.End()
+
+	path := fmt.Sprintf("/cosmos/gov/%s/proposals/%s/votes/%s", version, proposalID, voter)
+
+	grpcQuery := GRPCQuery{
+		Method: fmt.Sprintf("cosmos.gov.%s.Query/Vote", version),
+		Request: map[string]any{
+			"proposal_id": proposalID,
+			"voter":       voter,
+		},
+	}
+
+	var response *types.GovVoteResponse
+
+	info, err := rpc.Query(path, grpcQuery, &response, childQuerierCtx)
+	info.Address = voter
+
+	if err != nil && isVoteNotFound(err) {
+		info.Success = true
+		info.ErrorClass = constants.QueryErrorClassNone
+		return nil, &info, nil
+	}
+
+	if err != nil {
+		return nil, &info, err
+	}
+
+	if response.Code != 0 {
+		info.Success = false
+		return nil, &info, fmt.Errorf("expected code 0, but got %d", response.Code)
+	}
+
+	return &response.Vote, &info, nil
+}
+
+// isVoteNotFound also accepts InvalidArgument, which older Cosmos SDK versions return.
+func isVoteNotFound(err error) bool {
+	code := grpcCodes.Unknown
+
+	var httpErr *http.QueryError
+	if errors.As(err, &httpErr) {
+		code = grpcCodes.Code(httpErr.Code)
+	} else if grpcStatus, ok := status.FromError(err); ok {
+		code = grpcStatus.Code()
+	}
+
+	switch code {
+	case grpcCodes.NotFound:
+		return true
+	case grpcCodes.InvalidArgument:
+		return strings.Contains(err.Error(), "not found for proposal")
+	default:
+		return false
+	}
+}
@@ -0,0 +1,45 @@
+package tendermint
+
+import "sync"
+
+type HeightCache[T any] struct {
+	mutex sync.Mutex
+	data  map[int64]T
+}
+
+func NewHeightCache[T any]() *HeightCache[T] {
+	return &HeightCache[T]{data: map[int64]T{}}
+}
+
+func (c *HeightCache[T]) Get(height int64) (T, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	value, found := c.data[height]
+	return value, found
+}
+
+func (c *HeightCache[T]) Set(height int64, value T) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.data[height] = value
+}
+
+func (c *HeightCache[T]) Prune(minHeight int64) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	for height := range c.data {
+		if height < minHeight {
+			delete(c.data, height)
+		}
+	}
+}
+
+func (c *HeightCache[T]) Len() int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return len(c.data)
+}
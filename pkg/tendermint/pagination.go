@@ -0,0 +1,112 @@
+package tendermint
+
+import (
+	"context"
+	"fmt"
+	"main/pkg/types"
+	"maps"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func queryAllPages[T any, PT interface {
+	*T
+	types.PaginatedResponse
+}](
+	ctx context.Context,
+	rpc *RPC,
+	path string,
+	grpcQuery GRPCQuery,
+) ([]PT, types.QueryInfo, error) {
+	pageSize := rpc.Pagination.GetPageSize()
+	maxPages := rpc.Pagination.GetMaxPages()
+
+	pages := []PT{}
+	nextKey := ""
+
+	var info types.QueryInfo
+
+	for page := 1; ; page++ {
+		if page > maxPages {
+			info.Success = false
+			return nil, info, fmt.Errorf("the response has more than %d pages of %d items", maxPages, pageSize)
+		}
+
+		pagePath, pageQuery := getPageQuery(path, grpcQuery, pageSize, nextKey)
+
+		pageCtx, span := rpc.Tracer.Start(
+			ctx,
+			"Fetching page",
+			trace.WithAttributes(attribute.Int("page", page)),
+		)
+
+		response := PT(new(T))
+		pageInfo, err := rpc.Query(pagePath, pageQuery, response, pageCtx)
+		span.End()
+
+		if page == 1 {
+			info = pageInfo
+			info.Pages = 1
+
+			height := rpc.getMinHeight(pagePath, 0)
+			if err == nil && height > 0 && GetPinnedHeight(ctx, rpc.ChainName) == 0 {
+				ctx = WithPinnedHeight(ctx, rpc.ChainName, height)
+			}
+		} else {
+			info.Pages++
+			info.Duration += pageInfo.Duration
+			info.Attempts += pageInfo.Attempts
+			info.QueueWait += pageInfo.QueueWait
+			info.StatusCode = pageInfo.StatusCode
+			info.ErrorClass = pageInfo.ErrorClass
+			info.Success = pageInfo.Success
+		}
+
+		if err != nil {
+			return nil, info, err
+		}
+
+		if response.GetCode() != 0 {
+			info.Success = false
+			return nil, info, fmt.Errorf("expected code 0, but got %d", response.GetCode())
+		}
+
+		pages = append(pages, response)
+
+		if response.GetNextKey() == "" {
+			return pages, info, nil
+		}
+
+		nextKey = response.GetNextKey()
+	}
+}
+
+func getPageQuery(
+	path string,
+	grpcQuery GRPCQuery,
+	pageSize int,
+	nextKey string,
+) (string, GRPCQuery) {
+	separator := "?"
+	if strings.Contains(path, "?") {
+		separator = "&"
+	}
+
+	pagePath := path + separator + "pagination.limit=" + strconv.Itoa(pageSize)
+	pagination := map[string]any{"limit": pageSize}
+
+	if nextKey != "" {
+		pagePath += "&pagination.key=" + url.QueryEscape(nextKey)
+		pagination["key"] = nextKey
+	}
+
+	request := make(map[string]any, len(grpcQuery.Request)+1)
+	maps.Copy(request, grpcQuery.Request)
+	request["pagination"] = pagination
+
+	return pagePath, GRPCQuery{Method: grpcQuery.Method, Request: request}
+}
@@ -0,0 +1,119 @@
+package tendermint
+
+import (
+	"context"
+	"main/pkg/config"
+	"main/pkg/logger"
+	"main/pkg/tracing"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetPageQuery(t *testing.T) {
+	t.Parallel()
+
+	grpcQuery := GRPCQuery{Method: "method", Request: map[string]any{"address": "wallet"}}
+
+	path, query := getPageQuery("/balances/wallet", grpcQuery, 100, "")
+	assert.Equal(t, "/balances/wallet?pagination.limit=100", path)
+	assert.Equal(t, map[string]any{
+		"address":    "wallet",
+		"pagination": map[string]any{"limit": 100},
+	}, query.Request)
+
+	path, query = getPageQuery("/supply?denom=uatom", grpcQuery, 100, "a2V5/+==")
+	assert.Equal(t, "/supply?denom=uatom&pagination.limit=100&pagination.key=a2V5%2F%2B%3D%3D", path)
+	assert.Equal(t, map[string]any{"limit": 100, "key": "a2V5/+=="}, query.Request["pagination"])
+
+	// the original request is not modified
+	assert.Equal(t, map[string]any{"address": "wallet"}, grpcQuery.Request)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestRPCQueryAllPages(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://first.example/cosmos/bank/v1beta1/supply?pagination.limit=1",
+		func(request *http.Request) (*http.Response, error) {
+			assert.Empty(t, request.Header.Get("x-cosmos-block-height"))
+
+			response := httpmock.NewStringResponse(
+				200,
+				`{"supply":[{"denom":"uatom","amount":"100"}],"pagination":{"next_key":"a2V5/+==","total":"0"}}`,
+			)
+			response.Header.Set("Grpc-Metadata-X-Cosmos-Block-Height", "100")
+			return response, nil
+		},
+	)
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://first.example/cosmos/bank/v1beta1/supply?pagination.limit=1&pagination.key=a2V5%2F%2B%3D%3D",
+		func(request *http.Request) (*http.Response, error) {
+			// the next pages are queried at the height of the first one
+			assert.Equal(t, "100", request.Header.Get("x-cosmos-block-height"))
+
+			response := httpmock.NewStringResponse(
+				200,
+				`{"supply":[{"denom":"uosmo","amount":"200"}],"pagination":{"next_key":null,"total":"0"}}`,
+			)
+			response.Header.Set("Grpc-Metadata-X-Cosmos-Block-Height", "100")
+			return response, nil
+		},
+	)
+
+	chain := &config.Chain{
+		Name:        "chain",
+		LCDEndpoint: "https://first.example",
+		Pagination:  config.PaginationConfig{PageSize: 1},
+	}
+	rpc := NewRPC(chain, 10, *logger.GetNopLogger(), tracing.InitNoopTracer())
+
+	supply, query, err := rpc.GetTotalSupply(context.Background())
+	require.NoError(t, err)
+	require.Len(t, supply, 2)
+	assert.Equal(t, "uatom", supply[0].Denom)
+	assert.Equal(t, "uosmo", supply[1].Denom)
+
+	assert.True(t, query.Success)
+	assert.Equal(t, 2, query.Pages)
+	assert.Equal(t, 2, query.Attempts)
+	assert.Equal(t, "https://first.example/cosmos/bank/v1beta1/supply?pagination.limit=1", query.URL)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestRPCQueryAllPagesTooManyPages(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	// a node returning the same next_key over and over
+	httpmock.RegisterResponder(
+		"GET",
+		`=~^https://first\.example/cosmos/bank/v1beta1/supply`,
+		httpmock.NewStringResponder(
+			200,
+			`{"supply":[{"denom":"uatom","amount":"100"}],"pagination":{"next_key":"a2V5","total":"0"}}`,
+		),
+	)
+
+	chain := &config.Chain{
+		Name:        "chain",
+		LCDEndpoint: "https://first.example",
+		Pagination:  config.PaginationConfig{PageSize: 1, MaxPages: 3},
+	}
+	rpc := NewRPC(chain, 10, *logger.GetNopLogger(), tracing.InitNoopTracer())
+
+	supply, query, err := rpc.GetTotalSupply(context.Background())
+	require.Error(t, err)
+	assert.Nil(t, supply)
+	assert.False(t, query.Success)
+	assert.Equal(t, 3, query.Pages)
+	assert.Equal(t, 3, httpmock.GetTotalCallCount())
+}
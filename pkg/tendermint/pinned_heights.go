@@ -0,0 +1,85 @@
+package tendermint
+
+import (
+	"context"
+	"main/pkg/types"
+	"maps"
+	"sync"
+)
+
+type PinnedHeights map[string]int64
+
+type pinnedHeightsKey struct{}
+
+func WithPinnedHeights(ctx context.Context, heights PinnedHeights) context.Context {
+	return context.WithValue(ctx, pinnedHeightsKey{}, heights)
+}
+
+func WithPinnedHeight(ctx context.Context, chain string, height int64) context.Context {
+	heights := PinnedHeights{}
+
+	existing, ok := ctx.Value(pinnedHeightsKey{}).(PinnedHeights)
+	if ok {
+		maps.Copy(heights, existing)
+	}
+
+	heights[chain] = height
+
+	return WithPinnedHeights(ctx, heights)
+}
+
+func GetPinnedHeight(ctx context.Context, chain string) int64 {
+	heights, ok := ctx.Value(pinnedHeightsKey{}).(PinnedHeights)
+	if !ok {
+		return 0
+	}
+
+	return heights[chain]
+}
+
+func PinHeights(
+	ctx context.Context,
+	rpcs map[string]*RPCWithConsumers,
+) (PinnedHeights, []*types.QueryInfo) {
+	heights := PinnedHeights{}
+	queryInfos := []*types.QueryInfo{}
+
+	var (
+		mutex sync.Mutex
+		wg    sync.WaitGroup
+	)
+
+	for _, rpcWithConsumers := range rpcs {
+		for _, rpc := range append([]*RPC{rpcWithConsumers.RPC}, rpcWithConsumers.Consumers...) {
+			if !rpc.PinHeight {
+				continue
+			}
+
+			wg.Add(1)
+
+			go func(rpc *RPC) {
+				defer wg.Done()
+
+				height, queryInfo, err := rpc.GetLatestHeight(ctx)
+
+				mutex.Lock()
+				defer mutex.Unlock()
+
+				queryInfos = append(queryInfos, queryInfo)
+
+				if err != nil {
+					rpc.Logger.Warn().
+						Err(err).
+						Msg("Could not get the latest height, not pinning it")
+					return
+				}
+
+				heights[rpc.ChainName] = height
+			}(rpc)
+		}
+	}
+
+	wg.Wait()
+
+	return heights, queryInfos
+}
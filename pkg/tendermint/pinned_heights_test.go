@@ -0,0 +1,137 @@
+package tendermint
+
+import (
+	"context"
+	"errors"
+	"main/assets"
+	"main/pkg/config"
+	"main/pkg/logger"
+	"main/pkg/tracing"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetPinnedHeightNotPinned(t *testing.T) {
+	t.Parallel()
+
+	assert.Zero(t, GetPinnedHeight(context.Background(), "chain"))
+
+	ctx := WithPinnedHeights(context.Background(), PinnedHeights{"chain": 100})
+	assert.Equal(t, int64(100), GetPinnedHeight(ctx, "chain"))
+	assert.Zero(t, GetPinnedHeight(ctx, "other"))
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestPinHeights(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://pinned.example/cosmos/base/tendermint/v1beta1/blocks/latest",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("latest-block.json")),
+	)
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://failing.example/cosmos/base/tendermint/v1beta1/blocks/latest",
+		httpmock.NewErrorResponder(errors.New("custom error")),
+	)
+
+	chain := &config.Chain{
+		Name:        "pinned",
+		LCDEndpoint: "https://pinned.example",
+		PinHeight:   true,
+		ConsumerChains: []*config.ConsumerChain{
+			{Name: "failing", LCDEndpoint: "https://failing.example", PinHeight: true},
+			{Name: "not-pinned", LCDEndpoint: "https://not-pinned.example"},
+		},
+	}
+
+	rpcs := map[string]*RPCWithConsumers{
+		"pinned": RPCWithConsumersFromChain(chain, 10, *logger.GetNopLogger(), tracing.InitNoopTracer()),
+	}
+
+	heights, queries := PinHeights(context.Background(), rpcs)
+	assert.Equal(t, PinnedHeights{"pinned": 22000000}, heights)
+	assert.Len(t, queries, 2)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestRPCQueryAtPinnedHeight(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://first.example/cosmos/base/tendermint/v1beta1/node_info",
+		func(request *http.Request) (*http.Response, error) {
+			assert.Equal(t, "100", request.Header.Get("x-cosmos-block-height"))
+
+			response := httpmock.NewBytesResponse(200, assets.GetBytesOrPanic("node-info.json"))
+			response.Header.Set("Grpc-Metadata-X-Cosmos-Block-Height", "100")
+			return response, nil
+		},
+	)
+
+	chain := &config.Chain{Name: "chain", LCDEndpoint: "https://first.example"}
+	rpc := NewRPC(chain, 10, *logger.GetNopLogger(), tracing.InitNoopTracer())
+
+	// the previous response was at a higher height, but the pinned height takes precedence
+	rpc.LastHeight["/cosmos/base/tendermint/v1beta1/node_info"] = 150
+
+	ctx := WithPinnedHeights(context.Background(), PinnedHeights{"chain": 100})
+
+	nodeInfo, query, err := rpc.GetNodeInfo(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, nodeInfo)
+	assert.True(t, query.Success)
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+	assert.Equal(t, int64(150), rpc.LastHeight["/cosmos/base/tendermint/v1beta1/node_info"])
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestRPCFailoverOnResponseCodeAtPinnedHeight(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://first.example/cosmos/base/tendermint/v1beta1/node_info",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("error.json")),
+	)
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://second.example/cosmos/base/tendermint/v1beta1/node_info",
+		func(request *http.Request) (*http.Response, error) {
+			response := httpmock.NewBytesResponse(200, assets.GetBytesOrPanic("node-info.json"))
+			response.Header.Set("Grpc-Metadata-X-Cosmos-Block-Height", "100")
+			return response, nil
+		},
+	)
+
+	chain := &config.Chain{
+		Name:         "chain",
+		LCDEndpoints: []string{"https://first.example", "https://second.example"},
+	}
+	rpc := NewRPC(chain, 10, *logger.GetNopLogger(), tracing.InitNoopTracer())
+
+	// the first node might have not reached the pinned height yet
+	ctx := WithPinnedHeights(context.Background(), PinnedHeights{"chain": 100})
+
+	_, query, err := rpc.GetNodeInfo(ctx)
+	require.NoError(t, err)
+	assert.True(t, query.Success)
+	assert.Equal(t, "https://second.example/cosmos/base/tendermint/v1beta1/node_info", query.URL)
+
+	// the app error is not the node's failure
+	for _, stats := range rpc.Endpoints.Stats() {
+		assert.Zero(t, stats.Errors)
+		assert.Zero(t, stats.HeightRegressions)
+	}
+}
@@ -0,0 +1,126 @@
+package tendermint
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"main/pkg/constants"
+	"main/pkg/types"
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// GetRecentProposers fails if any of the batches fails, as partial counts would be misleading.
+func (rpc *RPC) GetRecentProposers(ctx context.Context) (*types.BlockProposers, []*types.QueryInfo, error) {
+	if !rpc.ChainQueries.Enabled("proposers") || rpc.Comet == nil {
+		return nil, nil, nil
+	}
+
+	childQuerierCtx, span := rpc.Tracer.Start(
+		ctx,
+		"Fetching recent proposers",
+		trace.WithAttributes(attribute.Int("blocks", rpc.ProposerBlocks)),
+	)
+	defer span.End()
+
+	queryInfos := []*types.QueryInfo{}
+
+	pinnedHeight := GetPinnedHeight(ctx, rpc.ChainName)
+	firstMinHeight := int64(0)
+
+	if pinnedHeight > 0 {
+		firstMinHeight = max(1, pinnedHeight-constants.CometBlockchainMaxBlocks+1)
+	}
+
+	latest, info, err := rpc.Comet.GetBlockchain(childQuerierCtx, firstMinHeight, pinnedHeight)
+	queryInfos = append(queryInfos, info)
+	if err != nil {
+		return nil, queryInfos, err
+	}
+
+	if len(latest.BlockMetas) == 0 {
+		return nil, queryInfos, errors.New("got no blocks")
+	}
+
+	latestHeight := latest.BlockMetas[0].Header.Height
+
+	for _, meta := range latest.BlockMetas {
+		latestHeight = max(latestHeight, meta.Header.Height)
+		rpc.Comet.BlockMetas.Set(meta.Header.Height, meta)
+	}
+
+	oldestHeight := max(1, latestHeight-int64(rpc.ProposerBlocks)+1)
+
+	type heightsRange struct {
+		minHeight int64
+		maxHeight int64
+	}
+
+	ranges := []heightsRange{}
+
+	for maxHeight := latestHeight; maxHeight >= oldestHeight; {
+		if _, found := rpc.Comet.BlockMetas.Get(maxHeight); found {
+			maxHeight--
+			continue
+		}
+
+		// extending the batch down to the first cached block
+		minHeight := maxHeight
+		for minHeight > max(oldestHeight, maxHeight-constants.CometBlockchainMaxBlocks+1) {
+			if _, found := rpc.Comet.BlockMetas.Get(minHeight - 1); found {
+				break
+			}
+
+			minHeight--
+		}
+
+		ranges = append(ranges, heightsRange{minHeight: minHeight, maxHeight: maxHeight})
+		maxHeight = minHeight - 1
+	}
+
+	var (
+		mutex sync.Mutex
+		errs  []error
+	)
+
+	forEachParallel(len(ranges), func(index int) {
+		minHeight, maxHeight := ranges[index].minHeight, ranges[index].maxHeight
+
+		blockchain, blockchainInfo, blockchainErr := rpc.Comet.GetBlockchain(childQuerierCtx, minHeight, maxHeight)
+
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		queryInfos = append(queryInfos, blockchainInfo)
+		if blockchainErr != nil {
+			errs = append(errs, fmt.Errorf(
+				"error fetching blocks from %d to %d: %s",
+				minHeight,
+				maxHeight,
+				blockchainErr,
+			))
+			return
+		}
+
+		for _, meta := range blockchain.BlockMetas {
+			rpc.Comet.BlockMetas.Set(meta.Header.Height, meta)
+		}
+	})
+
+	rpc.Comet.BlockMetas.Prune(oldestHeight)
+
+	if len(errs) > 0 {
+		return nil, queryInfos, errs[0]
+	}
+
+	windowMetas := []types.CometBlockMeta{}
+	for height := oldestHeight; height <= latestHeight; height++ {
+		if meta, found := rpc.Comet.BlockMetas.Get(height); found {
+			windowMetas = append(windowMetas, meta)
+		}
+	}
+
+	return types.NewBlockProposers(windowMetas), queryInfos, nil
+}
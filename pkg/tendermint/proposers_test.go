@@ -0,0 +1,212 @@
+package tendermint
+
+import (
+	"context"
+	"errors"
+	"main/assets"
+	"main/pkg/config"
+	"main/pkg/logger"
+	"main/pkg/tracing"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProposersNoCometRPC(t *testing.T) {
+	t.Parallel()
+
+	chain := &config.Chain{Name: "chain", LCDEndpoint: "https://api.cosmos.quokkastake.io"}
+	rpc := NewRPC(chain, 10, *logger.GetNopLogger(), tracing.InitNoopTracer())
+
+	proposers, queries, err := rpc.GetRecentProposers(context.Background())
+	require.NoError(t, err)
+	assert.Nil(t, proposers)
+	assert.Empty(t, queries)
+}
+
+func TestProposersQueryDisabled(t *testing.T) {
+	t.Parallel()
+
+	chain := &config.Chain{
+		Name:           "chain",
+		LCDEndpoint:    "https://api.cosmos.quokkastake.io",
+		RPCEndpoint:    "https://rpc.cosmos.quokkastake.io",
+		ProposerBlocks: 25,
+	}
+	rpc := NewRPC(chain, 10, *logger.GetNopLogger(), tracing.InitNoopTracer())
+	rpc.ChainQueries = config.Queries{"proposers": false}
+
+	proposers, queries, err := rpc.GetRecentProposers(context.Background())
+	require.NoError(t, err)
+	assert.Nil(t, proposers)
+	assert.Empty(t, queries)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestProposersSuccess(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://rpc.cosmos.quokkastake.io/blockchain",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("comet-blockchain-latest.json")),
+	)
+	httpmock.RegisterResponder(
+		"GET",
+		"https://rpc.cosmos.quokkastake.io/blockchain?maxHeight=80&minHeight=76",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("comet-blockchain-range.json")),
+	)
+
+	chain := &config.Chain{
+		Name:           "chain",
+		LCDEndpoint:    "https://api.cosmos.quokkastake.io",
+		RPCEndpoint:    "https://rpc.cosmos.quokkastake.io",
+		ProposerBlocks: 25,
+	}
+	rpc := NewRPC(chain, 10, *logger.GetNopLogger(), tracing.InitNoopTracer())
+
+	proposers, queries, err := rpc.GetRecentProposers(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, queries, 2)
+
+	for _, query := range queries {
+		assert.True(t, query.Success)
+	}
+
+	require.NotNil(t, proposers)
+	assert.Equal(t, int64(25), proposers.Blocks)
+	assert.Equal(t, int64(8), proposers.Proposed["1AEA8AD7C2BB352C01CDFD6BE21CE8E3B6FCE593"])
+	assert.Equal(t, int64(1), proposers.ProposedEmpty["1AEA8AD7C2BB352C01CDFD6BE21CE8E3B6FCE593"])
+	assert.Equal(t, int64(17), proposers.Proposed["C56D5548CDE623AA588E1C189A7242A93BADB89B"])
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestProposersCached(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://rpc.cosmos.quokkastake.io/blockchain",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("comet-blockchain-latest.json")),
+	)
+	httpmock.RegisterResponder(
+		"GET",
+		"https://rpc.cosmos.quokkastake.io/blockchain?maxHeight=80&minHeight=76",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("comet-blockchain-range.json")),
+	)
+
+	chain := &config.Chain{
+		Name:           "chain",
+		LCDEndpoint:    "https://api.cosmos.quokkastake.io",
+		RPCEndpoint:    "https://rpc.cosmos.quokkastake.io",
+		ProposerBlocks: 25,
+	}
+	rpc := NewRPC(chain, 10, *logger.GetNopLogger(), tracing.InitNoopTracer())
+
+	_, _, err := rpc.GetRecentProposers(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 25, rpc.Comet.BlockMetas.Len())
+
+	proposers, queries, err := rpc.GetRecentProposers(context.Background())
+	require.NoError(t, err)
+	require.NotNil(t, proposers)
+	assert.Equal(t, int64(25), proposers.Blocks)
+	// only the latest blocks are queried again
+	assert.Len(t, queries, 1)
+	assert.Equal(t, 1, httpmock.GetCallCountInfo()["GET https://rpc.cosmos.quokkastake.io/blockchain?maxHeight=80&minHeight=76"])
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestProposersFewBlocks(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://rpc.cosmos.quokkastake.io/blockchain",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("comet-blockchain-latest.json")),
+	)
+
+	chain := &config.Chain{
+		Name:           "chain",
+		LCDEndpoint:    "https://api.cosmos.quokkastake.io",
+		RPCEndpoint:    "https://rpc.cosmos.quokkastake.io",
+		ProposerBlocks: 25,
+	}
+	rpc := NewRPC(chain, 10, *logger.GetNopLogger(), tracing.InitNoopTracer())
+	rpc.ProposerBlocks = 10
+
+	proposers, queries, err := rpc.GetRecentProposers(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, queries, 1)
+	require.NotNil(t, proposers)
+	assert.Equal(t, int64(10), proposers.Blocks)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestProposersPinnedHeight(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://rpc.cosmos.quokkastake.io/blockchain?maxHeight=100&minHeight=81",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("comet-blockchain-latest.json")),
+	)
+	httpmock.RegisterResponder(
+		"GET",
+		"https://rpc.cosmos.quokkastake.io/blockchain?maxHeight=80&minHeight=76",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("comet-blockchain-range.json")),
+	)
+
+	chain := &config.Chain{
+		Name:           "chain",
+		LCDEndpoint:    "https://api.cosmos.quokkastake.io",
+		RPCEndpoint:    "https://rpc.cosmos.quokkastake.io",
+		ProposerBlocks: 25,
+	}
+	rpc := NewRPC(chain, 10, *logger.GetNopLogger(), tracing.InitNoopTracer())
+
+	ctx := WithPinnedHeight(context.Background(), "chain", 100)
+
+	proposers, _, err := rpc.GetRecentProposers(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, proposers)
+	assert.Equal(t, int64(25), proposers.Blocks)
+	assert.Equal(t, 1, httpmock.GetCallCountInfo()["GET https://rpc.cosmos.quokkastake.io/blockchain?maxHeight=100&minHeight=81"])
+	assert.Zero(t, httpmock.GetCallCountInfo()["GET https://rpc.cosmos.quokkastake.io/blockchain"])
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestProposersBlockchainError(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://rpc.cosmos.quokkastake.io/blockchain",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("comet-blockchain-latest.json")),
+	)
+	httpmock.RegisterResponder(
+		"GET",
+		"https://rpc.cosmos.quokkastake.io/blockchain?maxHeight=80&minHeight=76",
+		httpmock.NewErrorResponder(errors.New("error")),
+	)
+
+	chain := &config.Chain{
+		Name:           "chain",
+		LCDEndpoint:    "https://api.cosmos.quokkastake.io",
+		RPCEndpoint:    "https://rpc.cosmos.quokkastake.io",
+		ProposerBlocks: 25,
+	}
+	rpc := NewRPC(chain, 10, *logger.GetNopLogger(), tracing.InitNoopTracer())
+
+	proposers, queries, err := rpc.GetRecentProposers(context.Background())
+	require.Error(t, err)
+	assert.Nil(t, proposers)
+	assert.Len(t, queries, 2)
+}
@@ -0,0 +1,117 @@
+package tendermint
+
+import (
+	"context"
+	"fmt"
+	"main/pkg/types"
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// GetRecentBlocks fails if any of the commits fails, as partial missed blocks would be misleading.
+func (rpc *RPC) GetRecentBlocks(ctx context.Context) (*types.RecentBlocks, []*types.QueryInfo, error) {
+	if !rpc.ChainQueries.Enabled("recent-blocks") || rpc.Comet == nil {
+		return nil, nil, nil
+	}
+
+	childQuerierCtx, span := rpc.Tracer.Start(
+		ctx,
+		"Fetching recent blocks",
+		trace.WithAttributes(attribute.Int("blocks", rpc.RecentBlocks)),
+	)
+	defer span.End()
+
+	queryInfos := []*types.QueryInfo{}
+
+	latestCommit, info, err := rpc.Comet.GetCommit(childQuerierCtx, GetPinnedHeight(ctx, rpc.ChainName))
+	queryInfos = append(queryInfos, info)
+	if err != nil {
+		return nil, queryInfos, err
+	}
+
+	latestHeight := latestCommit.SignedHeader.Commit.Height
+
+	// The latest commit is not final yet and might be missing some of the signatures.
+	if latestCommit.Canonical {
+		rpc.Comet.Commits.Set(latestHeight, latestCommit.SignedHeader.Commit)
+	} else {
+		latestHeight--
+	}
+
+	if latestHeight <= 0 {
+		return nil, queryInfos, fmt.Errorf("got invalid latest height: %d", latestHeight)
+	}
+
+	blocksCount := min(int64(rpc.RecentBlocks), latestHeight)
+	commits := make([]types.CometCommit, blocksCount)
+
+	var (
+		wg         sync.WaitGroup
+		mutex      sync.Mutex
+		validators []types.CometValidator
+		errs       []error
+	)
+
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+
+		validatorSet, validatorsInfo, validatorsErr := rpc.Comet.GetValidatorSet(childQuerierCtx, latestHeight)
+
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		queryInfos = append(queryInfos, validatorsInfo)
+		if validatorsErr != nil {
+			errs = append(errs, fmt.Errorf("error fetching validators: %s", validatorsErr))
+			return
+		}
+
+		validators = validatorSet
+	}()
+
+	missingIndexes := []int64{}
+
+	for index := range blocksCount {
+		if commit, found := rpc.Comet.Commits.Get(latestHeight - index); found {
+			commits[index] = commit
+		} else {
+			missingIndexes = append(missingIndexes, index)
+		}
+	}
+
+	forEachParallel(len(missingIndexes), func(missingIndex int) {
+		index := missingIndexes[missingIndex]
+		height := latestHeight - index
+
+		commit, commitInfo, commitErr := rpc.Comet.GetCommit(childQuerierCtx, height)
+
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		queryInfos = append(queryInfos, commitInfo)
+		if commitErr != nil {
+			errs = append(errs, fmt.Errorf("error fetching commit at %d: %s", height, commitErr))
+			return
+		}
+
+		if commit.Canonical {
+			rpc.Comet.Commits.Set(height, commit.SignedHeader.Commit)
+		}
+
+		commits[index] = commit.SignedHeader.Commit
+	})
+
+	wg.Wait()
+
+	rpc.Comet.Commits.Prune(latestHeight - blocksCount + 1)
+
+	if len(errs) > 0 {
+		return nil, queryInfos, errs[0]
+	}
+
+	return &types.RecentBlocks{Commits: commits, Validators: validators}, queryInfos, nil
+}
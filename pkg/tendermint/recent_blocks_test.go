@@ -0,0 +1,247 @@
+package tendermint
+
+import (
+	"context"
+	"errors"
+	"main/assets"
+	"main/pkg/config"
+	"main/pkg/logger"
+	"main/pkg/tracing"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecentBlocksNoCometRPC(t *testing.T) {
+	t.Parallel()
+
+	chain := &config.Chain{Name: "chain", LCDEndpoint: "https://api.cosmos.quokkastake.io"}
+	rpc := NewRPC(chain, 10, *logger.GetNopLogger(), tracing.InitNoopTracer())
+
+	blocks, queries, err := rpc.GetRecentBlocks(context.Background())
+	require.NoError(t, err)
+	assert.Nil(t, blocks)
+	assert.Empty(t, queries)
+}
+
+func TestRecentBlocksQueryDisabled(t *testing.T) {
+	t.Parallel()
+
+	chain := &config.Chain{
+		Name:         "chain",
+		LCDEndpoint:  "https://api.cosmos.quokkastake.io",
+		RPCEndpoint:  "https://rpc.cosmos.quokkastake.io",
+		RecentBlocks: 3,
+	}
+	rpc := NewRPC(chain, 10, *logger.GetNopLogger(), tracing.InitNoopTracer())
+	rpc.ChainQueries = config.Queries{"recent-blocks": false}
+
+	blocks, queries, err := rpc.GetRecentBlocks(context.Background())
+	require.NoError(t, err)
+	assert.Nil(t, blocks)
+	assert.Empty(t, queries)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestRecentBlocksSuccess(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://rpc.cosmos.quokkastake.io/commit",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("comet-recent-commit-101.json")),
+	)
+	httpmock.RegisterResponder(
+		"GET",
+		"https://rpc.cosmos.quokkastake.io/commit?height=100",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("comet-recent-commit-100.json")),
+	)
+	httpmock.RegisterResponder(
+		"GET",
+		"https://rpc.cosmos.quokkastake.io/commit?height=99",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("comet-recent-commit-99.json")),
+	)
+	httpmock.RegisterResponder(
+		"GET",
+		"https://rpc.cosmos.quokkastake.io/commit?height=98",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("comet-recent-commit-98.json")),
+	)
+	httpmock.RegisterResponder(
+		"GET",
+		"https://rpc.cosmos.quokkastake.io/validators?height=100&page=1&per_page=100",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("comet-recent-validators.json")),
+	)
+
+	chain := &config.Chain{
+		Name:         "chain",
+		LCDEndpoint:  "https://api.cosmos.quokkastake.io",
+		RPCEndpoint:  "https://rpc.cosmos.quokkastake.io",
+		RecentBlocks: 3,
+	}
+	rpc := NewRPC(chain, 10, *logger.GetNopLogger(), tracing.InitNoopTracer())
+
+	blocks, queries, err := rpc.GetRecentBlocks(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, queries, 5)
+
+	for _, query := range queries {
+		assert.True(t, query.Success)
+	}
+
+	require.NotNil(t, blocks)
+	require.Len(t, blocks.Commits, 3)
+	assert.Equal(t, int64(100), blocks.Commits[0].Height)
+	assert.Equal(t, int64(99), blocks.Commits[1].Height)
+	assert.Equal(t, int64(98), blocks.Commits[2].Height)
+	assert.Len(t, blocks.Validators, 2)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestRecentBlocksCached(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://rpc.cosmos.quokkastake.io/commit",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("comet-recent-commit-101.json")),
+	)
+	httpmock.RegisterResponder(
+		"GET",
+		"https://rpc.cosmos.quokkastake.io/commit?height=100",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("comet-recent-commit-100.json")),
+	)
+	httpmock.RegisterResponder(
+		"GET",
+		"https://rpc.cosmos.quokkastake.io/commit?height=99",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("comet-recent-commit-99.json")),
+	)
+	httpmock.RegisterResponder(
+		"GET",
+		"https://rpc.cosmos.quokkastake.io/commit?height=98",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("comet-recent-commit-98.json")),
+	)
+	httpmock.RegisterResponder(
+		"GET",
+		"https://rpc.cosmos.quokkastake.io/validators?height=100&page=1&per_page=100",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("comet-recent-validators.json")),
+	)
+
+	chain := &config.Chain{
+		Name:         "chain",
+		LCDEndpoint:  "https://api.cosmos.quokkastake.io",
+		RPCEndpoint:  "https://rpc.cosmos.quokkastake.io",
+		RecentBlocks: 3,
+	}
+	rpc := NewRPC(chain, 10, *logger.GetNopLogger(), tracing.InitNoopTracer())
+
+	_, _, err := rpc.GetRecentBlocks(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 3, rpc.Comet.Commits.Len())
+
+	blocks, queries, err := rpc.GetRecentBlocks(context.Background())
+	require.NoError(t, err)
+	require.NotNil(t, blocks)
+	require.Len(t, blocks.Commits, 3)
+	assert.Equal(t, int64(98), blocks.Commits[2].Height)
+	// only the latest commit and the validators are queried again
+	assert.Len(t, queries, 2)
+	assert.Equal(t, 1, httpmock.GetCallCountInfo()["GET https://rpc.cosmos.quokkastake.io/commit?height=98"])
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestRecentBlocksPinnedHeight(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://rpc.cosmos.quokkastake.io/commit",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("comet-recent-commit-101.json")),
+	)
+	httpmock.RegisterResponder(
+		"GET",
+		"https://rpc.cosmos.quokkastake.io/commit?height=100",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("comet-recent-commit-100.json")),
+	)
+	httpmock.RegisterResponder(
+		"GET",
+		"https://rpc.cosmos.quokkastake.io/commit?height=99",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("comet-recent-commit-99.json")),
+	)
+	httpmock.RegisterResponder(
+		"GET",
+		"https://rpc.cosmos.quokkastake.io/commit?height=98",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("comet-recent-commit-98.json")),
+	)
+	httpmock.RegisterResponder(
+		"GET",
+		"https://rpc.cosmos.quokkastake.io/validators?height=100&page=1&per_page=100",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("comet-recent-validators.json")),
+	)
+
+	chain := &config.Chain{
+		Name:         "chain",
+		LCDEndpoint:  "https://api.cosmos.quokkastake.io",
+		RPCEndpoint:  "https://rpc.cosmos.quokkastake.io",
+		RecentBlocks: 3,
+	}
+	rpc := NewRPC(chain, 10, *logger.GetNopLogger(), tracing.InitNoopTracer())
+
+	ctx := WithPinnedHeight(context.Background(), "chain", 100)
+
+	blocks, _, err := rpc.GetRecentBlocks(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, blocks)
+	assert.Equal(t, int64(100), blocks.Commits[0].Height)
+	assert.Equal(t, 1, httpmock.GetCallCountInfo()["GET https://rpc.cosmos.quokkastake.io/commit?height=100"])
+	assert.Zero(t, httpmock.GetCallCountInfo()["GET https://rpc.cosmos.quokkastake.io/commit"])
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestRecentBlocksCommitError(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://rpc.cosmos.quokkastake.io/commit",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("comet-recent-commit-101.json")),
+	)
+	httpmock.RegisterResponder(
+		"GET",
+		"https://rpc.cosmos.quokkastake.io/commit?height=100",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("comet-recent-commit-100.json")),
+	)
+	httpmock.RegisterResponder(
+		"GET",
+		"https://rpc.cosmos.quokkastake.io/commit?height=99",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("comet-recent-commit-99.json")),
+	)
+	httpmock.RegisterResponder(
+		"GET",
+		"https://rpc.cosmos.quokkastake.io/commit?height=98",
+		httpmock.NewErrorResponder(errors.New("error")),
+	)
+	httpmock.RegisterResponder(
+		"GET",
+		"https://rpc.cosmos.quokkastake.io/validators?height=100&page=1&per_page=100",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("comet-recent-validators.json")),
+	)
+
+	chain := &config.Chain{
+		Name:         "chain",
+		LCDEndpoint:  "https://api.cosmos.quokkastake.io",
+		RPCEndpoint:  "https://rpc.cosmos.quokkastake.io",
+		RecentBlocks: 3,
+	}
+	rpc := NewRPC(chain, 10, *logger.GetNopLogger(), tracing.InitNoopTracer())
+
+	blocks, queries, err := rpc.GetRecentBlocks(context.Background())
+	require.Error(t, err)
+	assert.Nil(t, blocks)
+	assert.Len(t, queries, 5)
+}
@@ -2,12 +2,16 @@ package tendermint
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"main/pkg/config"
+	"main/pkg/constants"
+	grpcPkg "main/pkg/grpc"
 	"main/pkg/http"
 	"main/pkg/types"
 	"main/pkg/utils"
 	"sync"
+	"time"
 
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
@@ -16,30 +20,63 @@ import (
 )
 
 type RPC struct {
-	ChainName    string
-	ChainHost    string
-	ChainQueries config.Queries
-	Client       *http.Client
-	Timeout      int
-	Logger       zerolog.Logger
-	Tracer       trace.Tracer
+	ChainName      string
+	Endpoints      Endpoints
+	Comet          *CometRPC
+	GRPC           *grpcPkg.Client
+	GRPCBreaker    *CircuitBreaker
+	PinHeight      bool
+	MaxNodeLag     time.Duration
+	Breaker        *CircuitBreaker
+	Pagination     config.PaginationConfig
+	RecentBlocks   int
+	ProposerBlocks int
+	ChainQueries   config.Queries
+	Client         *http.Client
+	Timeout        int
+	Logger         zerolog.Logger
+	Tracer         trace.Tracer
 
 	LastHeight map[string]int64
 	Mutex      sync.Mutex
 }
 
+type GRPCQuery struct {
+	Method  string
+	Request map[string]any
+}
+
 func NewRPC(
 	chain config.ChainInfo,
 	timeout int,
 	logger zerolog.Logger,
 	tracer trace.Tracer,
 ) *RPC {
+	maxNodeLag := time.Duration(chain.GetMaxNodeLag()) * time.Second
+
 	return &RPC{
-		ChainName:    chain.GetName(),
-		ChainHost:    chain.GetHost(),
-		ChainQueries: chain.GetQueries(),
-		Client:       http.NewClient(&logger, chain.GetName(), tracer),
-		Timeout:      timeout,
+		ChainName:      chain.GetName(),
+		Endpoints:      NewEndpoints(chain.GetHosts(), maxNodeLag, chain.GetCircuitBreaker()),
+		Comet:          NewCometRPC(chain, timeout, logger, tracer),
+		GRPC:           newGRPCClient(chain, timeout, logger, tracer),
+		GRPCBreaker:    NewCircuitBreaker(chain.GetCircuitBreaker()),
+		PinHeight:      chain.GetPinHeight(),
+		MaxNodeLag:     maxNodeLag,
+		Breaker:        NewCircuitBreaker(chain.GetCircuitBreaker()),
+		Pagination:     chain.GetPagination(),
+		RecentBlocks:   chain.GetRecentBlocks(),
+		ProposerBlocks: chain.GetProposerBlocks(),
+		ChainQueries:   chain.GetQueries(),
+		Client: http.NewClient(
+			&logger,
+			chain.GetName(),
+			time.Duration(timeout)*time.Second,
+			chain.GetRetries(),
+			chain.GetTransport(),
+			chain.GetRateLimit(),
+			tracer,
+		),
+		Timeout: timeout,
 		Logger: logger.With().
 			Str("component", "rpc").
 			Str("chain", chain.GetName()).
@@ -64,15 +101,23 @@ func (rpc *RPC) GetDelegationsCount(
 	)
 	defer span.End()
 
-	url := fmt.Sprintf(
-		"%s/cosmos/staking/v1beta1/validators/%s/delegations?pagination.count_total=true&pagination.limit=1",
-		rpc.ChainHost,
+	path := fmt.Sprintf(
+		"/cosmos/staking/v1beta1/validators/%s/delegations?pagination.count_total=true&pagination.limit=1",
 		address,
 	)
 
+	grpcQuery := GRPCQuery{
+		Method: "cosmos.staking.v1beta1.Query/ValidatorDelegations",
+		Request: map[string]any{
+			"validator_addr": address,
+			"pagination":     map[string]any{"count_total": true, "limit": 1},
+		},
+	}
+
 	var response *types.PaginationResponse
 
-	info, err := rpc.Get(url, &response, childQuerierCtx)
+	info, err := rpc.Query(path, grpcQuery, &response, childQuerierCtx)
+	info.Address = address
 	if err != nil {
 		return nil, &info, err
 	}
@@ -100,15 +145,23 @@ func (rpc *RPC) GetUnbondsCount(
 	)
 	defer span.End()
 
-	url := fmt.Sprintf(
-		"%s/cosmos/staking/v1beta1/validators/%s/unbonding_delegations?pagination.count_total=true&pagination.limit=1",
-		rpc.ChainHost,
+	path := fmt.Sprintf(
+		"/cosmos/staking/v1beta1/validators/%s/unbonding_delegations?pagination.count_total=true&pagination.limit=1",
 		address,
 	)
 
+	grpcQuery := GRPCQuery{
+		Method: "cosmos.staking.v1beta1.Query/ValidatorUnbondingDelegations",
+		Request: map[string]any{
+			"validator_addr": address,
+			"pagination":     map[string]any{"count_total": true, "limit": 1},
+		},
+	}
+
 	var response *types.PaginationResponse
 
-	info, err := rpc.Get(url, &response, childQuerierCtx)
+	info, err := rpc.Query(path, grpcQuery, &response, childQuerierCtx)
+	info.Address = address
 	if err != nil {
 		return nil, &info, err
 	}
@@ -139,16 +192,24 @@ func (rpc *RPC) GetSingleDelegation(
 	)
 	defer span.End()
 
-	url := fmt.Sprintf(
-		"%s/cosmos/staking/v1beta1/validators/%s/delegations/%s",
-		rpc.ChainHost,
+	path := fmt.Sprintf(
+		"/cosmos/staking/v1beta1/validators/%s/delegations/%s",
 		validator,
 		wallet,
 	)
 
+	grpcQuery := GRPCQuery{
+		Method: "cosmos.staking.v1beta1.Query/Delegation",
+		Request: map[string]any{
+			"delegator_addr": wallet,
+			"validator_addr": validator,
+		},
+	}
+
 	var response types.SingleDelegationResponse
 
-	info, err := rpc.Get(url, &response, childQuerierCtx)
+	info, err := rpc.Query(path, grpcQuery, &response, childQuerierCtx)
+	info.Address = validator
 	if err != nil {
 		return &types.Amount{}, &info, err
 	}
@@ -176,18 +237,21 @@ func (rpc *RPC) GetAllValidators(
 	)
 	defer span.End()
 
-	url := rpc.ChainHost + "/cosmos/staking/v1beta1/validators?pagination.count_total=true&pagination.limit=10000"
+	path := "/cosmos/staking/v1beta1/validators"
 
-	var response *types.ValidatorsResponse
+	grpcQuery := GRPCQuery{
+		Method:  "cosmos.staking.v1beta1.Query/Validators",
+		Request: map[string]any{},
+	}
 
-	info, err := rpc.Get(url, &response, childQuerierCtx)
+	pages, info, err := queryAllPages[types.ValidatorsResponse](childQuerierCtx, rpc, path, grpcQuery)
 	if err != nil {
 		return nil, &info, err
 	}
 
-	if response.Code != 0 {
-		info.Success = false
-		return &types.ValidatorsResponse{}, &info, fmt.Errorf("expected code 0, but got %d", response.Code)
+	response := &types.ValidatorsResponse{Validators: []types.Validator{}}
+	for _, page := range pages {
+		response.Validators = append(response.Validators, page.Validators...)
 	}
 
 	return response, &info, nil
@@ -207,11 +271,18 @@ func (rpc *RPC) GetConsumerValidators(
 	)
 	defer span.End()
 
-	url := rpc.ChainHost + "/interchain_security/ccv/provider/consumer_validators/" + consumerID
+	path := "/interchain_security/ccv/provider/consumer_validators/" + consumerID
+
+	grpcQuery := GRPCQuery{
+		Method: "interchain_security.ccv.provider.v1.Query/QueryConsumerValidators",
+		Request: map[string]any{
+			"consumer_id": consumerID,
+		},
+	}
 
 	var response *types.ConsumerValidatorsResponse
 
-	info, err := rpc.Get(url, &response, childQuerierCtx)
+	info, err := rpc.Query(path, grpcQuery, &response, childQuerierCtx)
 	if err != nil {
 		return nil, &info, err
 	}
@@ -237,11 +308,18 @@ func (rpc *RPC) GetConsumerInfo(
 	)
 	defer span.End()
 
-	url := rpc.ChainHost + "/interchain_security/ccv/provider/consumer_chains/0" // "CONSUMER_PHASE_UNSPECIFIED"
+	path := "/interchain_security/ccv/provider/consumer_chains/0" // "CONSUMER_PHASE_UNSPECIFIED"
+
+	grpcQuery := GRPCQuery{
+		Method: "interchain_security.ccv.provider.v1.Query/QueryConsumerChains",
+		Request: map[string]any{
+			"phase": "CONSUMER_PHASE_UNSPECIFIED",
+		},
+	}
 
 	var response *types.ConsumerInfoResponse
 
-	info, err := rpc.Get(url, &response, childQuerierCtx)
+	info, err := rpc.Query(path, grpcQuery, &response, childQuerierCtx)
 	if err != nil {
 		return nil, &info, err
 	}
@@ -269,15 +347,22 @@ func (rpc *RPC) GetValidatorCommission(
 	)
 	defer span.End()
 
-	url := fmt.Sprintf(
-		"%s/cosmos/distribution/v1beta1/validators/%s/commission",
-		rpc.ChainHost,
+	path := fmt.Sprintf(
+		"/cosmos/distribution/v1beta1/validators/%s/commission",
 		address,
 	)
 
+	grpcQuery := GRPCQuery{
+		Method: "cosmos.distribution.v1beta1.Query/ValidatorCommission",
+		Request: map[string]any{
+			"validator_address": address,
+		},
+	}
+
 	var response *types.CommissionResponse
 
-	info, err := rpc.Get(url, &response, childQuerierCtx)
+	info, err := rpc.Query(path, grpcQuery, &response, childQuerierCtx)
+	info.Address = address
 	if err != nil {
 		return []types.Amount{}, &info, err
 	}
@@ -310,16 +395,24 @@ func (rpc *RPC) GetDelegatorRewards(
 	)
 	defer span.End()
 
-	url := fmt.Sprintf(
-		"%s/cosmos/distribution/v1beta1/delegators/%s/rewards/%s",
-		rpc.ChainHost,
+	path := fmt.Sprintf(
+		"/cosmos/distribution/v1beta1/delegators/%s/rewards/%s",
 		wallet,
 		validator,
 	)
 
+	grpcQuery := GRPCQuery{
+		Method: "cosmos.distribution.v1beta1.Query/DelegationRewards",
+		Request: map[string]any{
+			"delegator_address": wallet,
+			"validator_address": validator,
+		},
+	}
+
 	var response *types.RewardsResponse
 
-	info, err := rpc.Get(url, &response, childQuerierCtx)
+	info, err := rpc.Query(path, grpcQuery, &response, childQuerierCtx)
+	info.Address = validator
 	if err != nil {
 		return []types.Amount{}, &info, err
 	}
@@ -349,27 +442,32 @@ func (rpc *RPC) GetWalletBalance(
 	)
 	defer span.End()
 
-	url := fmt.Sprintf(
-		"%s/cosmos/bank/v1beta1/balances/%s",
-		rpc.ChainHost,
+	path := fmt.Sprintf(
+		"/cosmos/bank/v1beta1/balances/%s",
 		wallet,
 	)
 
-	var response types.BalancesResponse
+	grpcQuery := GRPCQuery{
+		Method: "cosmos.bank.v1beta1.Query/AllBalances",
+		Request: map[string]any{
+			"address": wallet,
+		},
+	}
 
-	info, err := rpc.Get(url, &response, childQuerierCtx)
+	pages, info, err := queryAllPages[types.BalancesResponse](childQuerierCtx, rpc, path, grpcQuery)
+	info.Address = wallet
 	if err != nil {
 		return []types.Amount{}, &info, err
 	}
 
-	if response.Code != 0 {
-		info.Success = false
-		return nil, &info, fmt.Errorf("expected code 0, but got %d", response.Code)
+	balances := []types.Amount{}
+	for _, page := range pages {
+		balances = append(balances, utils.Map(page.Balances, func(amount types.ResponseAmount) types.Amount {
+			return amount.ToAmount()
+		})...)
 	}
 
-	return utils.Map(response.Balances, func(amount types.ResponseAmount) types.Amount {
-		return amount.ToAmount()
-	}), &info, nil
+	return balances, &info, nil
 }
 
 func (rpc *RPC) GetConsumerAssignedKey(
@@ -391,16 +489,24 @@ func (rpc *RPC) GetConsumerAssignedKey(
 	)
 	defer span.End()
 
-	url := fmt.Sprintf(
-		"%s/interchain_security/ccv/provider/validator_consumer_addr/%s/%s",
-		rpc.ChainHost,
+	path := fmt.Sprintf(
+		"/interchain_security/ccv/provider/validator_consumer_addr/%s/%s",
 		consumerID,
 		valcons,
 	)
 
+	grpcQuery := GRPCQuery{
+		Method: "interchain_security.ccv.provider.v1.Query/QueryValidatorConsumerAddr",
+		Request: map[string]any{
+			"consumer_id":      consumerID,
+			"provider_address": valcons,
+		},
+	}
+
 	var response *types.AssignedKeyResponse
 
-	info, err := rpc.Get(url, &response, childQuerierCtx)
+	info, err := rpc.Query(path, grpcQuery, &response, childQuerierCtx)
+	info.Address = valcons
 	if err != nil {
 		return nil, &info, err
 	}
@@ -428,11 +534,19 @@ func (rpc *RPC) GetSigningInfo(
 	)
 	defer span.End()
 
-	url := fmt.Sprintf("%s/cosmos/slashing/v1beta1/signing_infos/%s", rpc.ChainHost, valcons)
+	path := "/cosmos/slashing/v1beta1/signing_infos/" + valcons
+
+	grpcQuery := GRPCQuery{
+		Method: "cosmos.slashing.v1beta1.Query/SigningInfo",
+		Request: map[string]any{
+			"cons_address": valcons,
+		},
+	}
 
 	var response *types.SigningInfoResponse
 
-	info, err := rpc.Get(url, &response, childQuerierCtx)
+	info, err := rpc.Query(path, grpcQuery, &response, childQuerierCtx)
+	info.Address = valcons
 	if err != nil {
 		return nil, &info, err
 	}
@@ -458,11 +572,16 @@ func (rpc *RPC) GetSlashingParams(
 	)
 	defer span.End()
 
-	url := rpc.ChainHost + "/cosmos/slashing/v1beta1/params"
+	path := "/cosmos/slashing/v1beta1/params"
+
+	grpcQuery := GRPCQuery{
+		Method:  "cosmos.slashing.v1beta1.Query/Params",
+		Request: map[string]any{},
+	}
 
 	var response *types.SlashingParamsResponse
 
-	info, err := rpc.Get(url, &response, childQuerierCtx)
+	info, err := rpc.Query(path, grpcQuery, &response, childQuerierCtx)
 	if err != nil {
 		return nil, &info, err
 	}
@@ -488,11 +607,16 @@ func (rpc *RPC) GetStakingParams(
 	)
 	defer span.End()
 
-	url := rpc.ChainHost + "/cosmos/staking/v1beta1/params"
+	path := "/cosmos/staking/v1beta1/params"
+
+	grpcQuery := GRPCQuery{
+		Method:  "cosmos.staking.v1beta1.Query/Params",
+		Request: map[string]any{},
+	}
 
 	var response *types.StakingParamsResponse
 
-	info, err := rpc.Get(url, &response, childQuerierCtx)
+	info, err := rpc.Query(path, grpcQuery, &response, childQuerierCtx)
 	if err != nil {
 		return nil, &info, err
 	}
@@ -518,11 +642,16 @@ func (rpc *RPC) GetNodeInfo(
 	)
 	defer span.End()
 
-	url := rpc.ChainHost + "/cosmos/base/tendermint/v1beta1/node_info"
+	path := "/cosmos/base/tendermint/v1beta1/node_info"
+
+	grpcQuery := GRPCQuery{
+		Method:  "cosmos.base.tendermint.v1beta1.Service/GetNodeInfo",
+		Request: map[string]any{},
+	}
 
 	var response *types.NodeInfoResponse
 
-	info, err := rpc.Get(url, &response, childQuerierCtx)
+	info, err := rpc.Query(path, grpcQuery, &response, childQuerierCtx)
 	if err != nil {
 		return nil, &info, err
 	}
@@ -549,11 +678,19 @@ func (rpc *RPC) GetValidatorConsumerChains(
 	)
 	defer span.End()
 
-	url := rpc.ChainHost + "/interchain_security/ccv/provider/consumer_chains_per_validator/" + valcons
+	path := "/interchain_security/ccv/provider/consumer_chains_per_validator/" + valcons
+
+	grpcQuery := GRPCQuery{
+		Method: "interchain_security.ccv.provider.v1.Query/QueryConsumerChainsValidatorHasToValidate",
+		Request: map[string]any{
+			"provider_address": valcons,
+		},
+	}
 
 	var response *types.ValidatorConsumerChains
 
-	info, err := rpc.Get(url, &response, childQuerierCtx)
+	info, err := rpc.Query(path, grpcQuery, &response, childQuerierCtx)
+	info.Address = valcons
 	if err != nil {
 		return nil, &info, err
 	}
@@ -581,11 +718,20 @@ func (rpc *RPC) GetConsumerCommission(
 	)
 	defer span.End()
 
-	url := rpc.ChainHost + "/interchain_security/ccv/provider/consumer_commission_rate/" + consumerID + "/" + valcons
+	path := "/interchain_security/ccv/provider/consumer_commission_rate/" + consumerID + "/" + valcons
+
+	grpcQuery := GRPCQuery{
+		Method: "interchain_security.ccv.provider.v1.Query/QueryValidatorConsumerCommissionRate",
+		Request: map[string]any{
+			"consumer_id":      consumerID,
+			"provider_address": valcons,
+		},
+	}
 
 	var response *types.ConsumerCommissionResponse
 
-	info, err := rpc.Get(url, &response, childQuerierCtx)
+	info, err := rpc.Query(path, grpcQuery, &response, childQuerierCtx)
+	info.Address = valcons
 	if err != nil {
 		return nil, &info, err
 	}
@@ -609,11 +755,16 @@ func (rpc *RPC) GetInflation(ctx context.Context) (*types.InflationResponse, *ty
 	)
 	defer span.End()
 
-	url := rpc.ChainHost + "/cosmos/mint/v1beta1/inflation"
+	path := "/cosmos/mint/v1beta1/inflation"
+
+	grpcQuery := GRPCQuery{
+		Method:  "cosmos.mint.v1beta1.Query/Inflation",
+		Request: map[string]any{},
+	}
 
 	var response *types.InflationResponse
 
-	info, err := rpc.Get(url, &response, childQuerierCtx)
+	info, err := rpc.Query(path, grpcQuery, &response, childQuerierCtx)
 	if err != nil {
 		return nil, &info, err
 	}
@@ -637,58 +788,280 @@ func (rpc *RPC) GetTotalSupply(ctx context.Context) ([]types.Amount, *types.Quer
 	)
 	defer span.End()
 
-	url := rpc.ChainHost + "/cosmos/bank/v1beta1/supply?pagination.limit=10000&pagination.offset=0"
+	path := "/cosmos/bank/v1beta1/supply"
 
-	var response *types.SupplyResponse
+	grpcQuery := GRPCQuery{
+		Method:  "cosmos.bank.v1beta1.Query/TotalSupply",
+		Request: map[string]any{},
+	}
 
-	info, err := rpc.Get(url, &response, childQuerierCtx)
+	pages, info, err := queryAllPages[types.SupplyResponse](childQuerierCtx, rpc, path, grpcQuery)
 	if err != nil {
 		return nil, &info, err
 	}
 
+	supply := []types.Amount{}
+	for _, page := range pages {
+		supply = append(supply, utils.Map(page.Supply, func(amount types.ResponseAmount) types.Amount {
+			return amount.ToAmount()
+		})...)
+	}
+
+	return supply, &info, nil
+}
+
+func (rpc *RPC) GetLatestHeight(ctx context.Context) (int64, *types.QueryInfo, error) {
+	childQuerierCtx, span := rpc.Tracer.Start(
+		ctx,
+		"Fetching latest block height",
+	)
+	defer span.End()
+
+	path := "/cosmos/base/tendermint/v1beta1/blocks/latest"
+
+	grpcQuery := GRPCQuery{
+		Method:  "cosmos.base.tendermint.v1beta1.Service/GetLatestBlock",
+		Request: map[string]any{},
+	}
+
+	var response *types.LatestBlockResponse
+
+	info, err := rpc.Query(path, grpcQuery, &response, childQuerierCtx)
+	if err != nil {
+		return 0, &info, err
+	}
+
 	if response.Code != 0 {
 		info.Success = false
-		return []types.Amount{}, &info, fmt.Errorf("expected code 0, but got %d", response.Code)
+		return 0, &info, fmt.Errorf("expected code 0, but got %d", response.Code)
 	}
 
-	return utils.Map(response.Supply, func(amount types.ResponseAmount) types.Amount {
-		return amount.ToAmount()
-	}), &info, nil
+	return response.Block.Header.Height, &info, nil
 }
 
 func (rpc *RPC) Get(
-	url string,
+	path string,
 	target any,
 	ctx context.Context,
 ) (types.QueryInfo, error) {
-	rpc.Mutex.Lock()
+	pinnedHeight := GetPinnedHeight(ctx, rpc.ChainName)
+	previousHeight := rpc.getMinHeight(path, pinnedHeight)
 
-	previousHeight, found := rpc.LastHeight[url]
-	if !found {
-		previousHeight = 0
+	info := types.QueryInfo{
+		Chain:      rpc.ChainName,
+		URL:        path,
+		ErrorClass: constants.QueryErrorClassCircuitOpen,
+	}
+	err := errors.New("all LCD endpoints are skipped due to consecutive failures")
+
+	for _, endpoint := range rpc.Endpoints.Ordered() {
+		if !endpoint.Breaker.Allow() {
+			continue
+		}
+
+		url := endpoint.Host + path
+
+		endpointInfo, header, endpointErr := rpc.Client.GetAtHeight(
+			url,
+			pinnedHeight,
+			target,
+			types.HTTPPredicateCheckHeightAfter(previousHeight),
+			ctx,
+		)
+		info, err = endpointInfo, endpointErr
+
+		if info.ErrorClass == constants.QueryErrorClassResponseCode {
+			endpoint.RecordSuccess(info.Duration, 0)
+
+			// Other nodes would return the same app error, unless the node is below the pinned height.
+			if pinnedHeight == 0 {
+				return info, err
+			}
+
+			rpc.Logger.Debug().
+				Err(err).
+				Str("url", info.URL).
+				Int64("height", pinnedHeight).
+				Msg("Query failed at the pinned height, trying the next endpoint")
+
+			continue
+		}
+
+		if err != nil {
+			if endpoint.RecordFailure() {
+				rpc.Logger.Warn().
+					Str("endpoint", utils.RedactURL(endpoint.Host)).
+					Msg("Too many consecutive failures, skipping the endpoint for a while")
+			}
+
+			if info.ErrorClass == constants.QueryErrorClassStaleHeight {
+				endpoint.RecordHeightRegression()
+			}
+
+			rpc.Logger.Warn().
+				Err(err).
+				Str("url", info.URL).
+				Msg("Query failed, trying the next endpoint")
+
+			if ctx.Err() != nil {
+				break
+			}
+
+			continue
+		}
+
+		height, _ := utils.GetBlockHeightFromHeader(header)
+
+		if pinnedHeight > 0 {
+			// the height is not the latest one for this endpoint, so not recording it
+			endpoint.RecordSuccess(info.Duration, 0)
+		} else {
+			endpoint.RecordSuccess(info.Duration, height)
+			rpc.setLastHeight(path, height)
+		}
+
+		rpc.Logger.Trace().
+			Str("url", info.URL).
+			Int64("height", height).
+			Msg("Got response at height")
+
+		return info, nil
 	}
-	rpc.Mutex.Unlock()
 
-	info, header, err := rpc.Client.Get(
-		url,
-		target,
-		types.HTTPPredicateCheckHeightAfter(previousHeight),
+	return info, err
+}
+
+func (rpc *RPC) Query(
+	path string,
+	grpcQuery GRPCQuery,
+	target any,
+	ctx context.Context,
+) (types.QueryInfo, error) {
+	if !rpc.Breaker.Allow() {
+		return types.QueryInfo{
+			Chain:      rpc.ChainName,
+			URL:        path,
+			ErrorClass: constants.QueryErrorClassCircuitOpen,
+		}, errors.New("queries to the chain are skipped due to consecutive failures")
+	}
+
+	info, err := rpc.query(path, grpcQuery, target, ctx)
+
+	switch {
+	case err == nil, info.ErrorClass == constants.QueryErrorClassResponseCode:
+		// the chain is up even if the query returned an error
+		rpc.Breaker.RecordSuccess()
+	case ctx.Err() != nil:
+		// the fetch was canceled, it says nothing about the chain
+	default:
+		if rpc.Breaker.RecordFailure() {
+			rpc.Logger.Warn().
+				Err(err).
+				Msg("Too many consecutive failed queries, skipping the queries to the chain for a while")
+		}
+	}
+
+	return info, err
+}
+
+func (rpc *RPC) query(
+	path string,
+	grpcQuery GRPCQuery,
+	target any,
+	ctx context.Context,
+) (types.QueryInfo, error) {
+	if rpc.GRPC == nil || !rpc.GRPCBreaker.Allow() {
+		return rpc.Get(path, target, ctx)
+	}
+
+	pinnedHeight := GetPinnedHeight(ctx, rpc.ChainName)
+
+	info, height, err := rpc.GRPC.Query(
 		ctx,
+		grpcQuery.Method,
+		grpcQuery.Request,
+		pinnedHeight,
+		rpc.getMinHeight(path, pinnedHeight),
+		target,
 	)
-	if err != nil {
+
+	switch {
+	case err == nil, info.ErrorClass == constants.QueryErrorClassResponseCode:
+		rpc.GRPCBreaker.RecordSuccess()
+	case ctx.Err() != nil:
+	default:
+		if rpc.GRPCBreaker.RecordFailure() {
+			rpc.Logger.Warn().
+				Err(err).
+				Msg("Too many consecutive failed gRPC queries, querying LCD only for a while")
+		}
+	}
+
+	if err == nil {
+		if pinnedHeight == 0 {
+			rpc.setLastHeight(path, height)
+		}
+
+		return info, nil
+	}
+
+	// LCD would return the same app error, unless the gRPC node is below the pinned height.
+	if (info.ErrorClass == constants.QueryErrorClassResponseCode && pinnedHeight == 0) || ctx.Err() != nil {
 		return info, err
 	}
 
-	height, _ := utils.GetBlockHeightFromHeader(header)
+	rpc.Logger.Warn().
+		Err(err).
+		Str("method", grpcQuery.Method).
+		Msg("gRPC query failed, falling back to LCD")
+
+	return rpc.Get(path, target, ctx)
+}
+
+func (rpc *RPC) getMinHeight(path string, pinnedHeight int64) int64 {
+	if pinnedHeight > 0 {
+		return pinnedHeight
+	}
 
 	rpc.Mutex.Lock()
-	rpc.LastHeight[url] = height
-	rpc.Mutex.Unlock()
+	defer rpc.Mutex.Unlock()
 
-	rpc.Logger.Trace().
-		Str("url", url).
-		Int64("height", height).
-		Msg("Got response at height")
+	return rpc.LastHeight[path]
+}
 
-	return info, err
+func (rpc *RPC) setLastHeight(path string, height int64) {
+	rpc.Mutex.Lock()
+	defer rpc.Mutex.Unlock()
+
+	rpc.LastHeight[path] = height
+}
+
+func newGRPCClient(
+	chain config.ChainInfo,
+	timeout int,
+	logger zerolog.Logger,
+	tracer trace.Tracer,
+) *grpcPkg.Client {
+	if chain.GetGRPCEndpoint() == "" {
+		return nil
+	}
+
+	client, err := grpcPkg.NewClient(
+		&logger,
+		chain.GetName(),
+		chain.GetGRPCEndpoint(),
+		time.Duration(timeout)*time.Second,
+		chain.GetTransport(),
+		chain.GetRateLimit(),
+		tracer,
+	)
+	if err != nil {
+		logger.Error().
+			Err(err).
+			Str("chain", chain.GetName()).
+			Msg("Could not create gRPC client, using LCD only")
+		return nil
+	}
+
+	return client
 }
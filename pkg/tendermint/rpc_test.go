@@ -0,0 +1,298 @@
+package tendermint
+
+import (
+	"context"
+	"errors"
+	"main/assets"
+	"main/pkg/config"
+	"main/pkg/constants"
+	"main/pkg/logger"
+	"main/pkg/tracing"
+	"net"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestRPCFailoverOnError(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://first.example/cosmos/base/tendermint/v1beta1/node_info",
+		httpmock.NewErrorResponder(errors.New("custom error")),
+	)
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://second.example/cosmos/base/tendermint/v1beta1/node_info",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("node-info.json")),
+	)
+
+	chain := &config.Chain{
+		Name:         "chain",
+		LCDEndpoints: []string{"https://first.example", "https://second.example"},
+	}
+	rpc := NewRPC(chain, 10, *logger.GetNopLogger(), tracing.InitNoopTracer())
+
+	nodeInfo, query, err := rpc.GetNodeInfo(context.Background())
+	require.NoError(t, err)
+	require.NotNil(t, nodeInfo)
+	assert.True(t, query.Success)
+	assert.Equal(t, "https://second.example/cosmos/base/tendermint/v1beta1/node_info", query.URL)
+
+	// the failing endpoint is not queried first anymore
+	_, query, err = rpc.GetNodeInfo(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "https://second.example/cosmos/base/tendermint/v1beta1/node_info", query.URL)
+	assert.Equal(t, 1, httpmock.GetCallCountInfo()["GET https://first.example/cosmos/base/tendermint/v1beta1/node_info"])
+
+	stats := rpc.Endpoints.Stats()
+	assert.Equal(t, int64(1), stats[0].Errors)
+	assert.Equal(t, int64(2), stats[1].Queries)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestRPCFailoverOnHeightRegression(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	respondAtHeight := func(height string) httpmock.Responder {
+		return func(request *http.Request) (*http.Response, error) {
+			response := httpmock.NewBytesResponse(200, assets.GetBytesOrPanic("node-info.json"))
+			response.Header.Set("Grpc-Metadata-X-Cosmos-Block-Height", height)
+			return response, nil
+		}
+	}
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://first.example/cosmos/base/tendermint/v1beta1/node_info",
+		respondAtHeight("100"),
+	)
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://second.example/cosmos/base/tendermint/v1beta1/node_info",
+		respondAtHeight("200"),
+	)
+
+	chain := &config.Chain{
+		Name:         "chain",
+		LCDEndpoints: []string{"https://first.example", "https://second.example"},
+	}
+	rpc := NewRPC(chain, 10, *logger.GetNopLogger(), tracing.InitNoopTracer())
+
+	// pretend the previous response for this query was at height 150
+	rpc.LastHeight["/cosmos/base/tendermint/v1beta1/node_info"] = 150
+
+	_, query, err := rpc.GetNodeInfo(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "https://second.example/cosmos/base/tendermint/v1beta1/node_info", query.URL)
+	assert.Equal(t, int64(200), rpc.LastHeight["/cosmos/base/tendermint/v1beta1/node_info"])
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestRPCAllEndpointsFail(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	chain := &config.Chain{
+		Name:         "chain",
+		LCDEndpoints: []string{"https://first.example", "https://second.example"},
+	}
+	rpc := NewRPC(chain, 10, *logger.GetNopLogger(), tracing.InitNoopTracer())
+
+	_, query, err := rpc.GetNodeInfo(context.Background())
+	require.Error(t, err)
+	assert.False(t, query.Success)
+
+	for _, stats := range rpc.Endpoints.Stats() {
+		assert.Equal(t, int64(1), stats.Errors)
+	}
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestRPCNoFailoverOnResponseCode(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://first.example/cosmos/base/tendermint/v1beta1/node_info",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("error.json")),
+	)
+
+	chain := &config.Chain{
+		Name:         "chain",
+		LCDEndpoints: []string{"https://first.example", "https://second.example"},
+	}
+	rpc := NewRPC(chain, 10, *logger.GetNopLogger(), tracing.InitNoopTracer())
+
+	_, query, err := rpc.GetNodeInfo(context.Background())
+	require.Error(t, err)
+	assert.False(t, query.Success)
+	assert.Equal(t, constants.QueryErrorClassResponseCode, query.ErrorClass)
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+
+	stats := rpc.Endpoints.Stats()
+	assert.Zero(t, stats[0].Errors)
+	assert.True(t, stats[0].Healthy)
+}
+
+func TestRPCNoGRPCEndpoint(t *testing.T) {
+	t.Parallel()
+
+	chain := &config.Chain{Name: "chain", LCDEndpoint: "https://first.example"}
+	rpc := NewRPC(chain, 10, *logger.GetNopLogger(), tracing.InitNoopTracer())
+	assert.Nil(t, rpc.GRPC)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestRPCGRPCFallbackToLCD(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://first.example/cosmos/base/tendermint/v1beta1/node_info",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("node-info.json")),
+	)
+
+	// nothing is listening there, so the gRPC query would fail
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	require.NoError(t, listener.Close())
+
+	chain := &config.Chain{
+		Name:         "chain",
+		LCDEndpoint:  "https://first.example",
+		GRPCEndpoint: "http://" + listener.Addr().String(),
+	}
+	rpc := NewRPC(chain, 10, *logger.GetNopLogger(), tracing.InitNoopTracer())
+	require.NotNil(t, rpc.GRPC)
+
+	nodeInfo, query, err := rpc.GetNodeInfo(context.Background())
+	require.NoError(t, err)
+	require.NotNil(t, nodeInfo)
+	assert.True(t, query.Success)
+	assert.Equal(t, "https://first.example/cosmos/base/tendermint/v1beta1/node_info", query.URL)
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestRPCGRPCCircuitBreaker(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://first.example/cosmos/base/tendermint/v1beta1/node_info",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("node-info.json")),
+	)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	require.NoError(t, listener.Close())
+
+	chain := &config.Chain{
+		Name:           "chain",
+		LCDEndpoint:    "https://first.example",
+		GRPCEndpoint:   "http://" + listener.Addr().String(),
+		CircuitBreaker: config.CircuitBreakerConfig{FailureThreshold: 1, CoolDown: 60},
+	}
+	rpc := NewRPC(chain, 10, *logger.GetNopLogger(), tracing.InitNoopTracer())
+
+	for range 2 {
+		_, query, err := rpc.GetNodeInfo(context.Background())
+		require.NoError(t, err)
+		assert.True(t, query.Success)
+	}
+
+	// the LCD fallback succeeded, so only the gRPC endpoint is skipped
+	assert.Equal(t, CircuitOpen, rpc.GRPCBreaker.State())
+	assert.Equal(t, CircuitClosed, rpc.Breaker.State())
+	assert.Equal(t, 2, httpmock.GetTotalCallCount())
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestRPCEndpointCircuitBreaker(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://first.example/cosmos/base/tendermint/v1beta1/node_info",
+		httpmock.NewErrorResponder(errors.New("custom error")),
+	)
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://second.example/cosmos/base/tendermint/v1beta1/node_info",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("node-info.json")),
+	)
+
+	chain := &config.Chain{
+		Name:           "chain",
+		LCDEndpoints:   []string{"https://first.example", "https://second.example"},
+		CircuitBreaker: config.CircuitBreakerConfig{FailureThreshold: 1, CoolDown: 60},
+	}
+	rpc := NewRPC(chain, 10, *logger.GetNopLogger(), tracing.InitNoopTracer())
+
+	for range 3 {
+		_, query, err := rpc.GetNodeInfo(context.Background())
+		require.NoError(t, err)
+		assert.True(t, query.Success)
+	}
+
+	assert.Equal(t, CircuitOpen, rpc.Endpoints.Stats()[0].CircuitState)
+	assert.Equal(t, CircuitClosed, rpc.Breaker.State())
+	assert.Equal(t, 1, httpmock.GetCallCountInfo()["GET https://first.example/cosmos/base/tendermint/v1beta1/node_info"])
+
+	// if all the endpoints are skipped, no queries are done
+	rpc.Endpoints[1].RecordFailure()
+
+	_, query, err := rpc.GetNodeInfo(context.Background())
+	require.Error(t, err)
+	assert.Equal(t, constants.QueryErrorClassCircuitOpen, query.ErrorClass)
+	assert.Equal(t, 4, httpmock.GetTotalCallCount())
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestRPCChainCircuitBreaker(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://first.example/cosmos/base/tendermint/v1beta1/node_info",
+		httpmock.NewErrorResponder(errors.New("custom error")),
+	)
+
+	chain := &config.Chain{
+		Name:           "chain",
+		LCDEndpoint:    "https://first.example",
+		CircuitBreaker: config.CircuitBreakerConfig{FailureThreshold: 2, CoolDown: 60},
+	}
+	rpc := NewRPC(chain, 10, *logger.GetNopLogger(), tracing.InitNoopTracer())
+	// so the endpoint is not skipped on its own
+	rpc.Endpoints[0].Breaker = NewCircuitBreaker(config.CircuitBreakerConfig{})
+
+	for range 2 {
+		_, _, err := rpc.GetNodeInfo(context.Background())
+		require.Error(t, err)
+	}
+
+	assert.Equal(t, CircuitOpen, rpc.Breaker.State())
+
+	_, query, err := rpc.GetNodeInfo(context.Background())
+	require.Error(t, err)
+	assert.False(t, query.Success)
+	assert.Equal(t, constants.QueryErrorClassCircuitOpen, query.ErrorClass)
+	assert.Equal(t, 2, httpmock.GetTotalCallCount())
+}
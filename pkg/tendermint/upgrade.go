@@ -0,0 +1,128 @@
+package tendermint
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"main/pkg/constants"
+	"main/pkg/types"
+	"strconv"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func (rpc *RPC) GetUpgradePlan(ctx context.Context) (*types.UpgradePlan, *types.QueryInfo, error) {
+	if !rpc.ChainQueries.Enabled("upgrade-plan") {
+		return nil, nil, nil
+	}
+
+	childQuerierCtx, span := rpc.Tracer.Start(
+		ctx,
+		"Fetching upgrade plan",
+	)
+	defer span.End()
+
+	path := "/cosmos/upgrade/v1beta1/current_plan"
+
+	grpcQuery := GRPCQuery{
+		Method:  "cosmos.upgrade.v1beta1.Query/CurrentPlan",
+		Request: map[string]any{},
+	}
+
+	var response *types.UpgradePlanResponse
+
+	info, err := rpc.Query(path, grpcQuery, &response, childQuerierCtx)
+	if err != nil {
+		return nil, &info, err
+	}
+
+	if response.Code != 0 {
+		info.Success = false
+		return nil, &info, fmt.Errorf("expected code 0, but got %d", response.Code)
+	}
+
+	return response.Plan, &info, nil
+}
+
+func (rpc *RPC) GetBlockTime(ctx context.Context) (*types.BlockTime, []*types.QueryInfo, error) {
+	if !rpc.ChainQueries.Enabled("block-time") {
+		return nil, nil, nil
+	}
+
+	childQuerierCtx, span := rpc.Tracer.Start(
+		ctx,
+		"Fetching average block time",
+	)
+	defer span.End()
+
+	queryInfos := []*types.QueryInfo{}
+
+	latest, info, err := rpc.getBlock(childQuerierCtx, GetPinnedHeight(ctx, rpc.ChainName))
+	queryInfos = append(queryInfos, info)
+	if err != nil {
+		return nil, queryInfos, err
+	}
+
+	earlierHeight := max(1, latest.Block.Header.Height-constants.BlockTimeEstimateBlocks)
+	if earlierHeight == latest.Block.Header.Height {
+		return nil, queryInfos, errors.New("not enough blocks to estimate block time")
+	}
+
+	earlier, info, err := rpc.getBlock(childQuerierCtx, earlierHeight)
+	queryInfos = append(queryInfos, info)
+	if err != nil {
+		return nil, queryInfos, err
+	}
+
+	return types.NewBlockTime(latest, earlier), queryInfos, nil
+}
+
+func (rpc *RPC) getBlock(ctx context.Context, height int64) (*types.LatestBlockResponse, *types.QueryInfo, error) {
+	childQuerierCtx, span := rpc.Tracer.Start(
+		ctx,
+		"Fetching block",
+		trace.WithAttributes(attribute.Int64("height", height)),
+	)
+	defer span.End()
+
+	path := "/cosmos/base/tendermint/v1beta1/blocks/latest"
+
+	grpcQuery := GRPCQuery{
+		Method:  "cosmos.base.tendermint.v1beta1.Service/GetLatestBlock",
+		Request: map[string]any{},
+	}
+
+	if height > 0 {
+		path = fmt.Sprintf("/cosmos/base/tendermint/v1beta1/blocks/%d", height)
+		grpcQuery = GRPCQuery{
+			Method: "cosmos.base.tendermint.v1beta1.Service/GetBlockByHeight",
+			Request: map[string]any{
+				"height": strconv.FormatInt(height, 10),
+			},
+		}
+
+		// so the path is not recorded with the latest height returned for it
+		childQuerierCtx = WithPinnedHeight(childQuerierCtx, rpc.ChainName, height)
+	}
+
+	var response *types.LatestBlockResponse
+
+	info, err := rpc.Query(path, grpcQuery, &response, childQuerierCtx)
+	if err != nil {
+		return nil, &info, err
+	}
+
+	if response.Code != 0 {
+		info.Success = false
+		return nil, &info, fmt.Errorf("expected code 0, but got %d", response.Code)
+	}
+
+	if height > 0 && response.Block.Header.Height != height {
+		info.Success = false
+		info.ErrorClass = constants.QueryErrorClassStaleHeight
+		return nil, &info, fmt.Errorf("requested height %d, but got %d", height, response.Block.Header.Height)
+	}
+
+	return response, &info, nil
+}
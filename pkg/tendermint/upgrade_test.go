@@ -0,0 +1,178 @@
+package tendermint
+
+import (
+	"context"
+	"errors"
+	"main/assets"
+	"main/pkg/config"
+	"main/pkg/logger"
+	"main/pkg/tracing"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpgradePlanQueryDisabled(t *testing.T) {
+	t.Parallel()
+
+	chain := &config.Chain{
+		Name:        "chain",
+		LCDEndpoint: "https://api.cosmos.quokkastake.io",
+	}
+	rpc := NewRPC(chain, 10, *logger.GetNopLogger(), tracing.InitNoopTracer())
+	rpc.ChainQueries = config.Queries{"upgrade-plan": false, "block-time": false}
+
+	plan, query, err := rpc.GetUpgradePlan(context.Background())
+	require.NoError(t, err)
+	assert.Nil(t, plan)
+	assert.Nil(t, query)
+
+	blockTime, queries, err := rpc.GetBlockTime(context.Background())
+	require.NoError(t, err)
+	assert.Nil(t, blockTime)
+	assert.Empty(t, queries)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestUpgradePlanNotScheduled(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://api.cosmos.quokkastake.io/cosmos/upgrade/v1beta1/current_plan",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("upgrade-plan-empty.json")),
+	)
+
+	chain := &config.Chain{
+		Name:        "chain",
+		LCDEndpoint: "https://api.cosmos.quokkastake.io",
+	}
+	rpc := NewRPC(chain, 10, *logger.GetNopLogger(), tracing.InitNoopTracer())
+
+	plan, query, err := rpc.GetUpgradePlan(context.Background())
+	require.NoError(t, err)
+	assert.True(t, query.Success)
+	assert.Nil(t, plan)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestUpgradePlanScheduled(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://api.cosmos.quokkastake.io/cosmos/upgrade/v1beta1/current_plan",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("upgrade-plan.json")),
+	)
+
+	chain := &config.Chain{
+		Name:        "chain",
+		LCDEndpoint: "https://api.cosmos.quokkastake.io",
+	}
+	rpc := NewRPC(chain, 10, *logger.GetNopLogger(), tracing.InitNoopTracer())
+
+	plan, query, err := rpc.GetUpgradePlan(context.Background())
+	require.NoError(t, err)
+	assert.True(t, query.Success)
+	require.NotNil(t, plan)
+	assert.Equal(t, "v22", plan.Name)
+	assert.Equal(t, int64(22001000), plan.Height)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestBlockTimeSuccess(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://api.cosmos.quokkastake.io/cosmos/base/tendermint/v1beta1/blocks/latest",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("latest-block.json")),
+	)
+	httpmock.RegisterResponder(
+		"GET",
+		"https://api.cosmos.quokkastake.io/cosmos/base/tendermint/v1beta1/blocks/21999900",
+		func(request *http.Request) (*http.Response, error) {
+			assert.Equal(t, "21999900", request.Header.Get("x-cosmos-block-height"))
+
+			response := httpmock.NewBytesResponse(200, assets.GetBytesOrPanic("earlier-block.json"))
+			response.Header.Set("Grpc-Metadata-X-Cosmos-Block-Height", "21999900")
+			return response, nil
+		},
+	)
+
+	chain := &config.Chain{
+		Name:        "chain",
+		LCDEndpoint: "https://api.cosmos.quokkastake.io",
+	}
+	rpc := NewRPC(chain, 10, *logger.GetNopLogger(), tracing.InitNoopTracer())
+
+	blockTime, queries, err := rpc.GetBlockTime(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, queries, 2)
+	require.NotNil(t, blockTime)
+	assert.Equal(t, int64(22000000), blockTime.Height)
+	assert.Equal(t, 6200*time.Millisecond, blockTime.AverageBlockTime)
+
+	// the earlier block's path is not remembered
+	assert.NotContains(t, rpc.LastHeight, "/cosmos/base/tendermint/v1beta1/blocks/21999900")
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestBlockTimeWrongHeight(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://api.cosmos.quokkastake.io/cosmos/base/tendermint/v1beta1/blocks/latest",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("latest-block.json")),
+	)
+	httpmock.RegisterResponder(
+		"GET",
+		"https://api.cosmos.quokkastake.io/cosmos/base/tendermint/v1beta1/blocks/21999900",
+		httpmock.NewBytesResponder(200, assets.GetBytesOrPanic("latest-block.json")).
+			HeaderSet(http.Header{"Grpc-Metadata-X-Cosmos-Block-Height": []string{"21999900"}}),
+	)
+
+	chain := &config.Chain{
+		Name:        "chain",
+		LCDEndpoint: "https://api.cosmos.quokkastake.io",
+	}
+	rpc := NewRPC(chain, 10, *logger.GetNopLogger(), tracing.InitNoopTracer())
+
+	blockTime, queries, err := rpc.GetBlockTime(context.Background())
+	require.Error(t, err)
+	assert.Nil(t, blockTime)
+	assert.Len(t, queries, 2)
+	assert.False(t, queries[1].Success)
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestBlockTimeError(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://api.cosmos.quokkastake.io/cosmos/base/tendermint/v1beta1/blocks/latest",
+		httpmock.NewErrorResponder(errors.New("error")),
+	)
+
+	chain := &config.Chain{
+		Name:        "chain",
+		LCDEndpoint: "https://api.cosmos.quokkastake.io",
+	}
+	rpc := NewRPC(chain, 10, *logger.GetNopLogger(), tracing.InitNoopTracer())
+
+	blockTime, queries, err := rpc.GetBlockTime(context.Background())
+	require.Error(t, err)
+	assert.Nil(t, blockTime)
+	assert.Len(t, queries, 1)
+}
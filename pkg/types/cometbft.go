@@ -0,0 +1,247 @@
+package types
+
+import (
+	"fmt"
+	"time"
+)
+
+type CometRPCError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Data    string `json:"data"`
+}
+
+func (e *CometRPCError) Error() string {
+	return fmt.Sprintf("RPC error %d: %s (%s)", e.Code, e.Message, e.Data)
+}
+
+type CometRPCResponse[T any] struct {
+	Result T `json:"result"`
+}
+
+type CometNodeInfo struct {
+	ID      string `json:"id"`
+	Network string `json:"network"`
+	Version string `json:"version"`
+	Moniker string `json:"moniker"`
+}
+
+type CometSyncInfo struct {
+	LatestBlockHash     string    `json:"latest_block_hash"`
+	LatestBlockHeight   int64     `json:"latest_block_height,string"`
+	LatestBlockTime     time.Time `json:"latest_block_time"`
+	EarliestBlockHeight int64     `json:"earliest_block_height,string"`
+	EarliestBlockTime   time.Time `json:"earliest_block_time"`
+	CatchingUp          bool      `json:"catching_up"`
+}
+
+type CometValidatorInfo struct {
+	Address     string `json:"address"`
+	VotingPower int64  `json:"voting_power,string"`
+}
+
+type CometStatus struct {
+	NodeInfo      CometNodeInfo      `json:"node_info"`
+	SyncInfo      CometSyncInfo      `json:"sync_info"`
+	ValidatorInfo CometValidatorInfo `json:"validator_info"`
+}
+
+type CometPeer struct {
+	NodeInfo   CometNodeInfo `json:"node_info"`
+	IsOutbound bool          `json:"is_outbound"`
+	RemoteIP   string        `json:"remote_ip"`
+}
+
+type CometNetInfo struct {
+	Listening  bool        `json:"listening"`
+	PeersCount int64       `json:"n_peers,string"`
+	Peers      []CometPeer `json:"peers"`
+}
+
+type CometBlockHeader struct {
+	ChainID         string    `json:"chain_id"`
+	Height          int64     `json:"height,string"`
+	Time            time.Time `json:"time"`
+	ProposerAddress string    `json:"proposer_address"`
+}
+
+type CometBlockIDFlag int
+
+const (
+	CometBlockIDFlagUnknown CometBlockIDFlag = 0
+	CometBlockIDFlagAbsent  CometBlockIDFlag = 1
+	CometBlockIDFlagCommit  CometBlockIDFlag = 2
+	CometBlockIDFlagNil     CometBlockIDFlag = 3
+)
+
+type CometCommitSignature struct {
+	BlockIDFlag      CometBlockIDFlag `json:"block_id_flag"`
+	ValidatorAddress string           `json:"validator_address"`
+	Timestamp        time.Time        `json:"timestamp"`
+}
+
+// Signed counts precommitting nil as signing, same as the slashing module does.
+func (s CometCommitSignature) Signed() bool {
+	return s.BlockIDFlag == CometBlockIDFlagCommit || s.BlockIDFlag == CometBlockIDFlagNil
+}
+
+type CometCommit struct {
+	Height     int64                  `json:"height,string"`
+	Round      int                    `json:"round"`
+	Signatures []CometCommitSignature `json:"signatures"`
+}
+
+type CometBlock struct {
+	Header     CometBlockHeader `json:"header"`
+	LastCommit CometCommit      `json:"last_commit"`
+}
+
+type CometBlockResponse struct {
+	Block CometBlock `json:"block"`
+}
+
+type CometBlockMeta struct {
+	Header CometBlockHeader `json:"header"`
+	NumTxs int64            `json:"num_txs,string"`
+}
+
+type CometBlockchainResponse struct {
+	LastHeight int64            `json:"last_height,string"`
+	BlockMetas []CometBlockMeta `json:"block_metas"`
+}
+
+type CometSignedHeader struct {
+	Header CometBlockHeader `json:"header"`
+	Commit CometCommit      `json:"commit"`
+}
+
+type CometCommitResponse struct {
+	SignedHeader CometSignedHeader `json:"signed_header"`
+	Canonical    bool              `json:"canonical"`
+}
+
+type CometValidator struct {
+	Address          string `json:"address"`
+	VotingPower      int64  `json:"voting_power,string"`
+	ProposerPriority int64  `json:"proposer_priority,string"`
+}
+
+type CometValidatorsResponse struct {
+	BlockHeight int64            `json:"block_height,string"`
+	Validators  []CometValidator `json:"validators"`
+	Count       int              `json:"count,string"`
+	Total       int              `json:"total,string"`
+}
+
+type CometVoteSet struct {
+	Round              int    `json:"round"`
+	PrevotesBitArray   string `json:"prevotes_bit_array"`
+	PrecommitsBitArray string `json:"precommits_bit_array"`
+}
+
+type CometRoundState struct {
+	Height     int64     `json:"height,string"`
+	Round      int       `json:"round"`
+	Step       int       `json:"step"`
+	StartTime  time.Time `json:"start_time"`
+	Validators struct {
+		Proposer CometValidator `json:"proposer"`
+	} `json:"validators"`
+	Votes []CometVoteSet `json:"votes"`
+}
+
+type CometConsensusPeer struct {
+	NodeAddress string `json:"node_address"`
+}
+
+type CometConsensusStateResponse struct {
+	RoundState CometRoundState      `json:"round_state"`
+	Peers      []CometConsensusPeer `json:"peers"`
+}
+
+type RecentBlocks struct {
+	Commits    []CometCommit
+	Validators []CometValidator
+}
+
+type ValidatorSignatures struct {
+	Blocks            int64
+	Missed            int64
+	ConsecutiveMissed int64
+	LastSignedHeight  int64
+}
+
+func (b RecentBlocks) GetValidatorSignatures(address string) *ValidatorSignatures {
+	inSet := false
+	for _, validator := range b.Validators {
+		if validator.Address == address {
+			inSet = true
+			break
+		}
+	}
+
+	if !inSet {
+		return nil
+	}
+
+	signatures := &ValidatorSignatures{Blocks: int64(len(b.Commits))}
+	streakEnded := false
+
+	for _, commit := range b.Commits {
+		signed := false
+		for _, signature := range commit.Signatures {
+			if signature.ValidatorAddress == address && signature.Signed() {
+				signed = true
+				break
+			}
+		}
+
+		if signed {
+			if signatures.LastSignedHeight == 0 {
+				signatures.LastSignedHeight = commit.Height
+			}
+
+			streakEnded = true
+			continue
+		}
+
+		signatures.Missed++
+
+		if !streakEnded {
+			signatures.ConsecutiveMissed++
+		}
+	}
+
+	return signatures
+}
+
+type BlockProposers struct {
+	Blocks        int64
+	Proposed      map[string]int64
+	ProposedEmpty map[string]int64
+}
+
+func NewBlockProposers(metas []CometBlockMeta) *BlockProposers {
+	proposers := &BlockProposers{
+		Proposed:      map[string]int64{},
+		ProposedEmpty: map[string]int64{},
+	}
+
+	counted := map[int64]bool{}
+
+	for _, meta := range metas {
+		if counted[meta.Header.Height] {
+			continue
+		}
+
+		counted[meta.Header.Height] = true
+		proposers.Blocks++
+		proposers.Proposed[meta.Header.ProposerAddress]++
+
+		if meta.NumTxs == 0 {
+			proposers.ProposedEmpty[meta.Header.ProposerAddress]++
+		}
+	}
+
+	return proposers
+}
@@ -0,0 +1,81 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecentBlocksGetValidatorSignaturesNotInSet(t *testing.T) {
+	t.Parallel()
+
+	blocks := RecentBlocks{
+		Commits:    []CometCommit{{Height: 10}},
+		Validators: []CometValidator{{Address: "AAAA"}},
+	}
+
+	assert.Nil(t, blocks.GetValidatorSignatures("BBBB"))
+}
+
+func TestRecentBlocksGetValidatorSignatures(t *testing.T) {
+	t.Parallel()
+
+	signed := func(address string) CometCommitSignature {
+		return CometCommitSignature{BlockIDFlag: CometBlockIDFlagCommit, ValidatorAddress: address}
+	}
+	absent := CometCommitSignature{BlockIDFlag: CometBlockIDFlagAbsent}
+
+	blocks := RecentBlocks{
+		Commits: []CometCommit{
+			{Height: 15, Signatures: []CometCommitSignature{signed("BBBB"), absent}},
+			{Height: 14, Signatures: []CometCommitSignature{signed("BBBB"), absent}},
+			{Height: 13, Signatures: []CometCommitSignature{signed("AAAA"), signed("BBBB")}},
+			{Height: 12, Signatures: []CometCommitSignature{absent, signed("BBBB")}},
+			{Height: 11, Signatures: []CometCommitSignature{
+				{BlockIDFlag: CometBlockIDFlagNil, ValidatorAddress: "AAAA"},
+				signed("BBBB"),
+			}},
+		},
+		Validators: []CometValidator{{Address: "AAAA"}, {Address: "BBBB"}},
+	}
+
+	signatures := blocks.GetValidatorSignatures("AAAA")
+	require.NotNil(t, signatures)
+	assert.Equal(t, &ValidatorSignatures{
+		Blocks:            5,
+		Missed:            3,
+		ConsecutiveMissed: 2,
+		LastSignedHeight:  13,
+	}, signatures)
+
+	signatures = blocks.GetValidatorSignatures("BBBB")
+	require.NotNil(t, signatures)
+	assert.Equal(t, &ValidatorSignatures{
+		Blocks:           5,
+		LastSignedHeight: 15,
+	}, signatures)
+}
+
+func TestNewBlockProposers(t *testing.T) {
+	t.Parallel()
+
+	meta := func(height int64, proposer string, txs int64) CometBlockMeta {
+		return CometBlockMeta{
+			Header: CometBlockHeader{Height: height, ProposerAddress: proposer},
+			NumTxs: txs,
+		}
+	}
+
+	proposers := NewBlockProposers([]CometBlockMeta{
+		meta(3, "AAAA", 1),
+		meta(2, "BBBB", 0),
+		meta(1, "AAAA", 0),
+		// duplicate
+		meta(1, "AAAA", 0),
+	})
+
+	assert.Equal(t, int64(3), proposers.Blocks)
+	assert.Equal(t, map[string]int64{"AAAA": 2, "BBBB": 1}, proposers.Proposed)
+	assert.Equal(t, map[string]int64{"AAAA": 1, "BBBB": 1}, proposers.ProposedEmpty)
+}
@@ -0,0 +1,133 @@
+package types
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"cosmossdk.io/math"
+)
+
+type GovProposalsResponse struct {
+	Code       int                   `json:"code"`
+	Proposals  []GovProposalResponse `json:"proposals"`
+	Pagination Pagination            `json:"pagination"`
+}
+
+func (r *GovProposalsResponse) GetCode() int {
+	return r.Code
+}
+
+func (r *GovProposalsResponse) GetNextKey() string {
+	return r.Pagination.NextKey
+}
+
+type GovProposalResponse struct {
+	// gov/v1
+	ID    string `json:"id"`
+	Title string `json:"title"`
+	// gov/v1beta1
+	ProposalID string `json:"proposal_id"`
+	Content    struct {
+		Title string `json:"title"`
+	} `json:"content"`
+
+	VotingEndTime time.Time `json:"voting_end_time"`
+}
+
+func (p GovProposalResponse) ToProposal() GovProposal {
+	proposal := GovProposal{
+		ID:            p.ID,
+		Title:         p.Title,
+		VotingEndTime: p.VotingEndTime,
+	}
+
+	if proposal.ID == "" {
+		proposal.ID = p.ProposalID
+	}
+
+	if proposal.Title == "" {
+		proposal.Title = p.Content.Title
+	}
+
+	return proposal
+}
+
+type GovTallyResponse struct {
+	Code  int            `json:"code"`
+	Tally GovTallyResult `json:"tally"`
+}
+
+type GovTallyResult struct {
+	// gov/v1
+	YesCount        string `json:"yes_count"`
+	AbstainCount    string `json:"abstain_count"`
+	NoCount         string `json:"no_count"`
+	NoWithVetoCount string `json:"no_with_veto_count"`
+	// gov/v1beta1
+	Yes        string `json:"yes"`
+	Abstain    string `json:"abstain"`
+	No         string `json:"no"`
+	NoWithVeto string `json:"no_with_veto"`
+}
+
+func (t GovTallyResult) ToTally() GovTally {
+	parse := func(v1 string, v1beta1 string) float64 {
+		value := v1
+		if value == "" {
+			value = v1beta1
+		}
+
+		parsed, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return 0
+		}
+
+		return parsed
+	}
+
+	return GovTally{
+		Yes:        parse(t.YesCount, t.Yes),
+		Abstain:    parse(t.AbstainCount, t.Abstain),
+		No:         parse(t.NoCount, t.No),
+		NoWithVeto: parse(t.NoWithVetoCount, t.NoWithVeto),
+	}
+}
+
+type GovVoteResponse struct {
+	Code int     `json:"code"`
+	Vote GovVote `json:"vote"`
+}
+
+type GovVote struct {
+	ProposalID string                  `json:"proposal_id"`
+	Voter      string                  `json:"voter"`
+	Options    []GovWeightedVoteOption `json:"options"`
+}
+
+type GovWeightedVoteOption struct {
+	Option string         `json:"option"`
+	Weight math.LegacyDec `json:"weight"`
+}
+
+func (o GovWeightedVoteOption) GetOption() string {
+	return strings.ToLower(strings.TrimPrefix(o.Option, "VOTE_OPTION_"))
+}
+
+type GovProposal struct {
+	ID            string
+	Title         string
+	VotingEndTime time.Time
+	Tally         *GovTally
+}
+
+type GovTally struct {
+	Yes        float64
+	Abstain    float64
+	No         float64
+	NoWithVeto float64
+}
+
+func (t GovTally) Total() float64 {
+	return t.Yes + t.Abstain + t.No + t.NoWithVeto
+}
@@ -60,17 +60,41 @@ type PaginationResponse struct {
 }
 
 type Pagination struct {
-	Total uint64 `json:"total,string"`
+	NextKey string `json:"next_key"`
+	Total   uint64 `json:"total,string"`
+}
+
+type PaginatedResponse interface {
+	GetCode() int
+	GetNextKey() string
 }
 
 type ValidatorsResponse struct {
 	Code       int         `json:"code"`
 	Validators []Validator `json:"validators"`
+	Pagination Pagination  `json:"pagination"`
+}
+
+func (r *ValidatorsResponse) GetCode() int {
+	return r.Code
+}
+
+func (r *ValidatorsResponse) GetNextKey() string {
+	return r.Pagination.NextKey
 }
 
 type BalancesResponse struct {
-	Code     int              `json:"code"`
-	Balances []ResponseAmount `json:"balances"`
+	Code       int              `json:"code"`
+	Balances   []ResponseAmount `json:"balances"`
+	Pagination Pagination       `json:"pagination"`
+}
+
+func (r *BalancesResponse) GetCode() int {
+	return r.Code
+}
+
+func (r *BalancesResponse) GetNextKey() string {
+	return r.Pagination.NextKey
 }
 
 type ResponseAmount struct {
@@ -105,7 +129,18 @@ type AssignedKeyResponse struct {
 }
 
 type SlashingParams struct {
-	SignedBlocksWindow math.Int `json:"signed_blocks_window"`
+	SignedBlocksWindow math.Int       `json:"signed_blocks_window"`
+	MinSignedPerWindow math.LegacyDec `json:"min_signed_per_window"`
+}
+
+// MaxMissedBlocks rounds the same way the slashing module does.
+func (p SlashingParams) MaxMissedBlocks() int64 {
+	window := p.SignedBlocksWindow.Int64()
+	if p.MinSignedPerWindow.IsNil() {
+		return window
+	}
+
+	return window - p.MinSignedPerWindow.MulInt64(window).RoundInt64()
 }
 
 type SlashingParamsResponse struct {
@@ -209,6 +244,30 @@ type InflationResponse struct {
 }
 
 type SupplyResponse struct {
-	Code   int              `json:"code"`
-	Supply []ResponseAmount `json:"supply"`
+	Code       int              `json:"code"`
+	Supply     []ResponseAmount `json:"supply"`
+	Pagination Pagination       `json:"pagination"`
+}
+
+func (r *SupplyResponse) GetCode() int {
+	return r.Code
+}
+
+func (r *SupplyResponse) GetNextKey() string {
+	return r.Pagination.NextKey
+}
+
+type LatestBlockResponse struct {
+	Code  int `json:"code"`
+	Block struct {
+		Header struct {
+			Height int64     `json:"height,string"`
+			Time   time.Time `json:"time"`
+		} `json:"header"`
+	} `json:"block"`
+}
+
+type SyncingResponse struct {
+	Code    int  `json:"code"`
+	Syncing bool `json:"syncing"`
 }
@@ -32,3 +32,23 @@ func TestResponseAmountToAmount(t *testing.T) {
 	assert.InDelta(t, 1.23, 0.0001, converted.Amount)
 	assert.Equal(t, "ustake", converted.Denom)
 }
+
+func TestSlashingParamsMaxMissedBlocks(t *testing.T) {
+	t.Parallel()
+
+	params := SlashingParams{
+		SignedBlocksWindow: math.NewInt(10000),
+		MinSignedPerWindow: math.LegacyMustNewDecFromStr("0.05"),
+	}
+	assert.Equal(t, int64(9500), params.MaxMissedBlocks())
+
+	params = SlashingParams{
+		SignedBlocksWindow: math.NewInt(100),
+		MinSignedPerWindow: math.LegacyMustNewDecFromStr("0.333"),
+	}
+	// 33.3 is rounded to 33, same as in the slashing module
+	assert.Equal(t, int64(67), params.MaxMissedBlocks())
+
+	params = SlashingParams{SignedBlocksWindow: math.NewInt(100)}
+	assert.Equal(t, int64(100), params.MaxMissedBlocks())
+}
@@ -1,14 +1,21 @@
 package types
 
 import (
+	"main/pkg/constants"
 	"time"
 )
 
 type QueryInfo struct {
-	Chain    string
-	URL      string
-	Duration time.Duration
-	Success  bool
+	Chain      string
+	URL        string
+	Duration   time.Duration
+	Success    bool
+	Attempts   int
+	StatusCode int
+	ErrorClass constants.QueryErrorClass
+	QueueWait  time.Duration
+	Pages      int
+	Address    string
 }
 
 type Amount struct {
@@ -0,0 +1,45 @@
+package types
+
+import "time"
+
+type UpgradePlanResponse struct {
+	Code int          `json:"code"`
+	Plan *UpgradePlan `json:"plan"`
+}
+
+type UpgradePlan struct {
+	Name   string `json:"name"`
+	Height int64  `json:"height,string"`
+	Info   string `json:"info"`
+}
+
+type BlockTime struct {
+	Height           int64
+	Time             time.Time
+	AverageBlockTime time.Duration
+}
+
+func NewBlockTime(latest, earlier *LatestBlockResponse) *BlockTime {
+	latestHeader := latest.Block.Header
+	earlierHeader := earlier.Block.Header
+
+	blockTime := &BlockTime{
+		Height: latestHeader.Height,
+		Time:   latestHeader.Time,
+	}
+
+	if latestHeader.Height > earlierHeader.Height {
+		blockTime.AverageBlockTime = latestHeader.Time.Sub(earlierHeader.Time) /
+			time.Duration(latestHeader.Height-earlierHeader.Height)
+	}
+
+	return blockTime
+}
+
+func (b *BlockTime) BlocksUntil(height int64) int64 {
+	return max(height-b.Height, 0)
+}
+
+func (b *BlockTime) DurationUntil(height int64) time.Duration {
+	return time.Duration(b.BlocksUntil(height)) * b.AverageBlockTime
+}
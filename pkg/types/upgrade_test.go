@@ -0,0 +1,29 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewBlockTime(t *testing.T) {
+	t.Parallel()
+
+	var latest, earlier LatestBlockResponse
+	latest.Block.Header.Height = 1100
+	latest.Block.Header.Time = time.Unix(1000, 0)
+	earlier.Block.Header.Height = 1000
+	earlier.Block.Header.Time = time.Unix(400, 0)
+
+	blockTime := NewBlockTime(&latest, &earlier)
+	assert.Equal(t, int64(1100), blockTime.Height)
+	assert.Equal(t, 6*time.Second, blockTime.AverageBlockTime)
+
+	assert.Equal(t, int64(100), blockTime.BlocksUntil(1200))
+	assert.Equal(t, 600*time.Second, blockTime.DurationUntil(1200))
+
+	// already reached
+	assert.Zero(t, blockTime.BlocksUntil(1000))
+	assert.Zero(t, blockTime.DurationUntil(1000))
+}
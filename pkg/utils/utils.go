@@ -2,9 +2,12 @@ package utils
 
 import (
 	"bytes"
+	"encoding/hex"
 	"main/pkg/constants"
 	"net/http"
+	"net/url"
 	"strconv"
+	"strings"
 
 	"github.com/tnakagawa/goref/bech32m"
 )
@@ -35,6 +38,32 @@ func ChangeBech32Prefix(source, newPrefix string) (string, error) {
 	return bech32m.Encode(newPrefix, bytes, bech32m.Bech32), nil
 }
 
+// Bech32ToHex returns the address as uppercase hex, the way CometBFT RPC returns it.
+func Bech32ToHex(address string) (string, error) {
+	_, data, _, err := bech32m.Decode(address)
+	if err != nil {
+		return "", err
+	}
+
+	var (
+		acc    uint
+		bits   uint
+		result []byte
+	)
+
+	for _, value := range data {
+		acc = acc<<5 | uint(value)
+		bits += 5
+
+		if bits >= 8 {
+			bits -= 8
+			result = append(result, byte(acc>>bits))
+		}
+	}
+
+	return strings.ToUpper(hex.EncodeToString(result)), nil
+}
+
 func Filter[T any](slice []T, f func(T) bool) []T {
 	var n []T
 
@@ -104,3 +133,58 @@ func GetBlockHeightFromHeader(header http.Header) (int64, error) {
 
 	return value, nil
 }
+
+const redactedValue = "xxxxx"
+
+func RedactURL(rawURL string) string {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL
+	}
+
+	redacted := false
+
+	if _, hasPassword := parsed.User.Password(); hasPassword {
+		parsed.User = url.UserPassword(parsed.User.Username(), redactedValue)
+		redacted = true
+	}
+
+	query := parsed.Query()
+	for name := range query {
+		if isSecretParam(name) {
+			query.Set(name, redactedValue)
+			redacted = true
+		}
+	}
+
+	if !redacted {
+		return rawURL
+	}
+
+	parsed.RawQuery = query.Encode()
+
+	return parsed.String()
+}
+
+// StripQuery drops the query params, like the height, so the URL can be used as a metric label.
+func StripQuery(rawURL string) string {
+	withoutQuery, _, _ := strings.Cut(rawURL, "?")
+	return withoutQuery
+}
+
+func isSecretParam(name string) bool {
+	normalized := strings.NewReplacer("-", "", "_", "").Replace(strings.ToLower(name))
+
+	switch normalized {
+	case "key", "token", "auth", "secret", "password", "signature":
+		return true
+	}
+
+	for _, suffix := range []string{"apikey", "token", "secret", "password"} {
+		if strings.HasSuffix(normalized, suffix) {
+			return true
+		}
+	}
+
+	return false
+}